@@ -7,6 +7,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/codegangsta/cli"
 	"github.com/jfrog/jfrog-cli-go/artifactory/commands"
@@ -25,18 +26,27 @@ import (
 	piputils "github.com/jfrog/jfrog-cli-go/artifactory/utils/pip"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildadddependencies"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildaddgit"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildappend"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildclean"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildcollectenv"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/builddiff"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/builddiscard"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/builddistribute"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildexportsbom"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildlistlocal"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildmerge"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildpromote"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildpublish"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildscan"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildshow"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/buildverifysignature"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/cleanup"
 	configdocs "github.com/jfrog/jfrog-cli-go/docs/artifactory/config"
 	copydocs "github.com/jfrog/jfrog-cli-go/docs/artifactory/copy"
 	curldocs "github.com/jfrog/jfrog-cli-go/docs/artifactory/curl"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/delete"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/deleteprops"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/diff"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/dockerpull"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/dockerpush"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/download"
@@ -47,6 +57,7 @@ import (
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/gorecursivepublish"
 	gradledoc "github.com/jfrog/jfrog-cli-go/docs/artifactory/gradle"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/gradleconfig"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/ls"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/move"
 	mvndoc "github.com/jfrog/jfrog-cli-go/docs/artifactory/mvn"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/mvnconfig"
@@ -59,15 +70,23 @@ import (
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/pipconfig"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/pipdepstree"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/pipinstall"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/propsexport"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/quarantinepurge"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/restore"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/search"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/setprops"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/stat"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/transfer"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/upload"
 	"github.com/jfrog/jfrog-cli-go/docs/artifactory/use"
+	"github.com/jfrog/jfrog-cli-go/docs/artifactory/verify"
 	"github.com/jfrog/jfrog-cli-go/docs/common"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
 	"github.com/jfrog/jfrog-cli-go/utils/ioutils"
 	logUtils "github.com/jfrog/jfrog-cli-go/utils/log"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
 	buildinfocmd "github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
@@ -109,7 +128,7 @@ func GetCommands() []cli.Command {
 			Usage:        upload.Description,
 			HelpName:     common.CreateUsage("rt upload", upload.Description, upload.Usage),
 			UsageText:    upload.Arguments,
-			ArgsUsage:    common.CreateEnvVars(upload.EnvVar),
+			ArgsUsage:    common.CreateEnvVars(upload.EnvVar, upload.SigningEnvVar, common.LimitRateEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return uploadCmd(c)
@@ -122,7 +141,7 @@ func GetCommands() []cli.Command {
 			Usage:        download.Description,
 			HelpName:     common.CreateUsage("rt download", download.Description, download.Usage),
 			UsageText:    download.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.LimitRateEnvVar, common.DownloadCacheEnvVars),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return downloadCmd(c)
@@ -154,6 +173,53 @@ func GetCommands() []cli.Command {
 				return copyCmd(c)
 			},
 		},
+		{
+			Name:         "restore",
+			Flags:        getRestoreFlags(),
+			Usage:        restore.Description,
+			HelpName:     common.CreateUsage("rt restore", restore.Description, restore.Usage),
+			UsageText:    restore.Arguments,
+			ArgsUsage:    common.CreateEnvVars(common.QuarantineRepoEnvVar),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return restoreCmd(c)
+			},
+		},
+		{
+			Name:         "quarantine-purge",
+			Flags:        getQuarantinePurgeFlags(),
+			Aliases:      []string{"qp"},
+			Usage:        quarantinepurge.Description,
+			HelpName:     common.CreateUsage("rt quarantine-purge", quarantinepurge.Description, quarantinepurge.Usage),
+			ArgsUsage:    common.CreateEnvVars(common.QuarantineRepoEnvVar),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return quarantinePurgeCmd(c)
+			},
+		},
+		{
+			Name:         "cleanup",
+			Flags:        getCleanupFlags(),
+			Usage:        cleanup.Description,
+			HelpName:     common.CreateUsage("rt cleanup", cleanup.Description, cleanup.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return cleanupCmd(c)
+			},
+		},
+		{
+			Name:         "transfer",
+			Flags:        getTransferFlags(),
+			Usage:        transfer.Description,
+			HelpName:     common.CreateUsage("rt transfer", transfer.Description, transfer.Usage),
+			UsageText:    transfer.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return transferCmd(c)
+			},
+		},
 		{
 			Name:         "delete",
 			Flags:        getDeleteFlags(),
@@ -161,7 +227,7 @@ func GetCommands() []cli.Command {
 			Usage:        delete.Description,
 			HelpName:     common.CreateUsage("rt delete", delete.Description, delete.Usage),
 			UsageText:    delete.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.QuarantineRepoEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return deleteCmd(c)
@@ -180,9 +246,57 @@ func GetCommands() []cli.Command {
 				return searchCmd(c)
 			},
 		},
+		{
+			Name:         "diff",
+			Flags:        getDiffFlags(),
+			Usage:        diff.Description,
+			HelpName:     common.CreateUsage("rt diff", diff.Description, diff.Usage),
+			UsageText:    diff.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return diffCmd(c)
+			},
+		},
+		{
+			Name:         "verify",
+			Flags:        getVerifyFlags(),
+			Usage:        verify.Description,
+			HelpName:     common.CreateUsage("rt verify", verify.Description, verify.Usage),
+			UsageText:    verify.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return verifyCmd(c)
+			},
+		},
+		{
+			Name:         "ls",
+			Flags:        getLsFlags(),
+			Usage:        ls.Description,
+			HelpName:     common.CreateUsage("rt ls", ls.Description, ls.Usage),
+			UsageText:    ls.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return lsCmd(c)
+			},
+		},
+		{
+			Name:         "stat",
+			Flags:        getStatFlags(),
+			Usage:        stat.Description,
+			HelpName:     common.CreateUsage("rt stat", stat.Description, stat.Usage),
+			UsageText:    stat.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return statCmd(c)
+			},
+		},
 		{
 			Name:         "set-props",
-			Flags:        getSetOrDeletePropsFlags(),
+			Flags:        getSetPropsFlags(),
 			Aliases:      []string{"sp"},
 			Usage:        setprops.Description,
 			HelpName:     common.CreateUsage("rt set-props", setprops.Description, setprops.Usage),
@@ -193,6 +307,19 @@ func GetCommands() []cli.Command {
 				return setPropsCmd(c)
 			},
 		},
+		{
+			Name:         "props-export",
+			Flags:        getPropsExportFlags(),
+			Aliases:      []string{"pe"},
+			Usage:        propsexport.Description,
+			HelpName:     common.CreateUsage("rt props-export", propsexport.Description, propsexport.Usage),
+			UsageText:    propsexport.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return propsExportCmd(c)
+			},
+		},
 		{
 			Name:         "delete-props",
 			Flags:        getSetOrDeletePropsFlags(),
@@ -221,6 +348,7 @@ func GetCommands() []cli.Command {
 		},
 		{
 			Name:         "build-collect-env",
+			Flags:        getBuildCollectEnvFlags(),
 			Aliases:      []string{"bce"},
 			Usage:        buildcollectenv.Description,
 			HelpName:     common.CreateUsage("rt build-collect-env", buildcollectenv.Description, buildcollectenv.Usage),
@@ -272,6 +400,7 @@ func GetCommands() []cli.Command {
 		},
 		{
 			Name:         "build-clean",
+			Flags:        getBuildCleanFlags(),
 			Aliases:      []string{"bc"},
 			Usage:        buildclean.Description,
 			HelpName:     common.CreateUsage("rt build-clean", buildclean.Description, buildclean.Usage),
@@ -282,6 +411,90 @@ func GetCommands() []cli.Command {
 				return buildCleanCmd(c)
 			},
 		},
+		{
+			Name:         "build-show",
+			Flags:        getBuildShowFlags(),
+			Usage:        buildshow.Description,
+			HelpName:     common.CreateUsage("rt build-show", buildshow.Description, buildshow.Usage),
+			UsageText:    buildshow.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildShowCmd(c)
+			},
+		},
+		{
+			Name:         "build-list-local",
+			Flags:        getBuildListLocalFlags(),
+			Usage:        buildlistlocal.Description,
+			HelpName:     common.CreateUsage("rt build-list-local", buildlistlocal.Description, buildlistlocal.Usage),
+			UsageText:    buildlistlocal.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildListLocalCmd(c)
+			},
+		},
+		{
+			Name:         "build-diff",
+			Flags:        getBuildDiffFlags(),
+			Usage:        builddiff.Description,
+			HelpName:     common.CreateUsage("rt build-diff", builddiff.Description, builddiff.Usage),
+			UsageText:    builddiff.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildDiffCmd(c)
+			},
+		},
+		{
+			Name:         "build-export-sbom",
+			Flags:        getBuildExportSbomFlags(),
+			Usage:        buildexportsbom.Description,
+			HelpName:     common.CreateUsage("rt build-export-sbom", buildexportsbom.Description, buildexportsbom.Usage),
+			UsageText:    buildexportsbom.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildExportSbomCmd(c)
+			},
+		},
+		{
+			Name:         "build-append",
+			Flags:        getBuildAppendFlags(),
+			Usage:        buildappend.Description,
+			HelpName:     common.CreateUsage("rt build-append", buildappend.Description, buildappend.Usage),
+			UsageText:    buildappend.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildAppendCmd(c)
+			},
+		},
+		{
+			Name:         "build-merge",
+			Flags:        getBuildMergeFlags(),
+			Usage:        buildmerge.Description,
+			HelpName:     common.CreateUsage("rt build-merge", buildmerge.Description, buildmerge.Usage),
+			UsageText:    buildmerge.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildMergeCmd(c)
+			},
+		},
+		{
+			Name:         "build-verify-signature",
+			Flags:        getBuildVerifySignatureFlags(),
+			Usage:        buildverifysignature.Description,
+			HelpName:     common.CreateUsage("rt build-verify-signature", buildverifysignature.Description, buildverifysignature.Usage),
+			UsageText:    buildverifysignature.Arguments,
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return buildVerifySignatureCmd(c)
+			},
+		},
 		{
 			Name:         "build-promote",
 			Flags:        getBuildPromotionFlags(),
@@ -340,7 +553,7 @@ func GetCommands() []cli.Command {
 			Usage:        mvndoc.Description,
 			HelpName:     common.CreateUsage("rt mvn", mvndoc.Description, mvndoc.Usage),
 			UsageText:    mvndoc.Arguments,
-			ArgsUsage:    common.CreateEnvVars(mvndoc.EnvVar),
+			ArgsUsage:    common.CreateEnvVars(mvndoc.EnvVar, common.DownloadCacheEnvVars),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return mvnCmd(c)
@@ -364,7 +577,7 @@ func GetCommands() []cli.Command {
 			Usage:        gradledoc.Description,
 			HelpName:     common.CreateUsage("rt gradle", gradledoc.Description, gradledoc.Usage),
 			UsageText:    gradledoc.Arguments,
-			ArgsUsage:    common.CreateEnvVars(gradledoc.EnvVar),
+			ArgsUsage:    common.CreateEnvVars(gradledoc.EnvVar, common.DownloadCacheEnvVars),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return gradleCmd(c)
@@ -389,7 +602,7 @@ func GetCommands() []cli.Command {
 			Usage:        dockerpush.Description,
 			HelpName:     common.CreateUsage("rt docker-push", dockerpush.Description, dockerpush.Usage),
 			UsageText:    dockerpush.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.LimitRateEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return dockerPushCmd(c)
@@ -490,7 +703,7 @@ func GetCommands() []cli.Command {
 			Usage:           gocommand.Description,
 			HelpName:        common.CreateUsage("rt go", gocommand.Description, gocommand.Usage),
 			UsageText:       gocommand.Arguments,
-			ArgsUsage:       common.CreateEnvVars(),
+			ArgsUsage:       common.CreateEnvVars(common.DownloadCacheEnvVars),
 			SkipFlagParsing: shouldSkipGoFlagParsing(),
 			BashComplete:    common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
@@ -567,7 +780,7 @@ func GetCommands() []cli.Command {
 			Usage:           pipinstall.Description,
 			HelpName:        common.CreateUsage("rt pipi", pipinstall.Description, pipinstall.Usage),
 			UsageText:       pipinstall.Arguments,
-			ArgsUsage:       common.CreateEnvVars(),
+			ArgsUsage:       common.CreateEnvVars(common.DownloadCacheEnvVars),
 			SkipFlagParsing: true,
 			BashComplete:    common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
@@ -705,8 +918,33 @@ func getUploadFlags() []cli.Flag {
 		getIncludeDirsFlag(),
 		getPropertiesFlag("Those properties will be attached to the uploaded artifacts."),
 		getUploadExcludePatternsFlag(),
+		cli.BoolFlag{
+			Name:  "watch",
+			Usage: "[Default: false] Set to true to keep running after the upload, and upload the files matched by the pattern as they are created or modified. If sync-deletes is set, files deleted locally are also deleted in Artifactory.` `",
+		},
+		cli.StringFlag{
+			Name:  "watch-interval",
+			Usage: "[Default: " + strconv.Itoa(cliutils.WatchIntervalSeconds) + "] Interval in seconds between scans for changed files, in watch mode. A changed file is uploaded once it is unchanged for a full interval.` `",
+		},
+		cli.BoolFlag{
+			Name:  "respect-ignore-files",
+			Usage: "[Default: false] Set to true to skip the files and directories matched by .gitignore and .jfrogignore files, including ignore files in sub-directories.` `",
+		},
+		cli.StringFlag{
+			Name:  "sign-keyring",
+			Usage: "[Optional] Path to a GPG keyring file. If set, a detached .asc signature of each uploaded file is generated and uploaded next to it. If the key is encrypted, its passphrase is read from the " + cliutils.SigningPassphrase + " environment variable.` `",
+		},
+		cli.StringFlag{
+			Name:  "sign-key-id",
+			Usage: "[Optional] ID or fingerprint of the signing key, if the keyring includes several private keys.` `",
+		},
+		cli.StringFlag{
+			Name:  "checksum-files",
+			Usage: "[Optional] List of checksum files to generate and upload next to each uploaded file, in the form of \"sha256,md5\". The files have the format of the sha256sum and md5sum tools.` `",
+		},
 		getFailNoOpFlag(),
 		getThreadsFlag(),
+		getLimitRateFlag(),
 		getSyncDeletesFlag("[Optional] Specific path in Artifactory, under which to sync artifacts after the upload. After the upload, this path will include only the artifacts uploaded during this upload operation. The other files under this path will be deleted.` `"),
 		getQuiteFlag("[Default: false] Set to true to skip the sync-deletes confirmation message.` `"),
 	}...)
@@ -761,6 +999,11 @@ func getDownloadFlags() []cli.Flag {
 		getFailNoOpFlag(),
 		getExcludePatternsFlag(),
 		getThreadsFlag(),
+		getLimitRateFlag(),
+		cli.BoolFlag{
+			Name:  "use-cache",
+			Usage: "[Default: false] Set to true to take files from the local download cache when they have the same checksum, instead of downloading them, and to add the downloaded files to the cache.` `",
+		},
 		getArchiveEntriesFlag(),
 		getSyncDeletesFlag("[Optional] Specific path in the local file system, under which to sync dependencies after the download. After the download, this path will include only the dependencies downloaded during this download operation. The other files under this path will be deleted.` `"),
 		getQuiteFlag("[Default: false] Set to true to skip the sync-deletes confirmation message.` `"),
@@ -839,6 +1082,10 @@ func getDockerPushFlags() []cli.Flag {
 	var flags []cli.Flag
 	flags = append(flags, getDockerFlags()...)
 	flags = append(flags, getThreadsFlag())
+	flags = append(flags, cli.StringFlag{
+		Name:  "limit-rate",
+		Usage: "[Optional] Maximum transfer rate of the image layers in bytes per second. The rate may be followed by K, M or G, for example 512K or 10M. If not set, the rate configured for the server is used. The image is pushed through a local registry which limits the rate, so the docker daemon should run on the same machine.` `",
+	})
 	return flags
 }
 
@@ -960,10 +1207,61 @@ func getMoveFlags() []cli.Flag {
 		getFailNoOpFlag(),
 		getExcludePatternsFlag(),
 		getArchiveEntriesFlag(),
+		getOnConflictFlag("moved"),
+		getVerifyFlag("moved"),
+		getThreadsFlag(),
 	}...)
 
 }
 
+func getOnConflictFlag(action string) cli.Flag {
+	return cli.StringFlag{
+		Name:  "on-conflict",
+		Usage: "[Default: overwrite] What to do when a different file already exists in the target path. Possible values: skip, overwrite, fail or rename. With fail, no artifact is " + action + " if any conflict is found. With rename, a numeric suffix is added to the file name.` `",
+	}
+}
+
+func getVerifyFlag(action string) cli.Flag {
+	return cli.BoolFlag{
+		Name:  "verify",
+		Usage: "[Default: false] Set to true to compare the checksums of the " + action + " artifacts in the target path with the source artifacts. Discrepancies are listed and fail the command.` `",
+	}
+}
+
+func getTransferFlags() []cli.Flag {
+	transferFlags := append(getSortLimitFlags(), getSpecFlags()...)
+	return append(transferFlags, []cli.Flag{
+		cli.StringFlag{
+			Name:  "source-server-id",
+			Usage: "[Mandatory] ID of the Artifactory server, configured using the config command, from which the artifacts are transferred.` `",
+		},
+		cli.StringFlag{
+			Name:  "target-server-id",
+			Usage: "[Mandatory] ID of the Artifactory server, configured using the config command, to which the artifacts are transferred.` `",
+		},
+		cli.BoolTFlag{
+			Name:  "recursive",
+			Usage: "[Default: true] Set to false if you do not wish to transfer artifacts inside sub-folders in Artifactory.` `",
+		},
+		cli.BoolFlag{
+			Name:  "flat",
+			Usage: "[Default: false] If set to false, files are transferred according to their file system hierarchy.` `",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "[Default: false] Set to true to only list the artifacts which would have been transferred. Nothing is deployed to the target server.` `",
+		},
+		cli.StringFlag{
+			Name:  "build",
+			Usage: "[Optional] If specified, only artifacts of the specified build are matched. The property format is build-name/build-number. If you do not specify the build number, the artifacts are filtered by the latest build number.` `",
+		},
+		getPropertiesFlag("Only artifacts with these properties will be transferred."),
+		getExcludePropertiesFlag("Only artifacts without the specified properties will be transferred"),
+		getFailNoOpFlag(),
+		getExcludePatternsFlag(),
+	}...)
+}
+
 func getCopyFlags() []cli.Flag {
 	copyFlags := append(getServerFlags(), getSortLimitFlags()...)
 	copyFlags = append(copyFlags, getSpecFlags()...)
@@ -989,6 +1287,9 @@ func getCopyFlags() []cli.Flag {
 		getFailNoOpFlag(),
 		getExcludePatternsFlag(),
 		getArchiveEntriesFlag(),
+		getOnConflictFlag("copied"),
+		getVerifyFlag("copied"),
+		getThreadsFlag(),
 	}...)
 }
 
@@ -1014,6 +1315,130 @@ func getDeleteFlags() []cli.Flag {
 		getFailNoOpFlag(),
 		getExcludePatternsFlag(),
 		getArchiveEntriesFlag(),
+		getQuarantineRepoFlag("[Optional] Repository into which the deleted artifacts are moved, instead of being permanently deleted. The deleted artifacts can later be restored using the restore command. If not set, the " + cliutils.QuarantineRepo + " environment variable is used.` `"),
+		getThreadsFlag(),
+	}...)
+}
+
+func getQuarantineRepoFlag(usage string) cli.Flag {
+	return cli.StringFlag{
+		Name:  "quarantine-repo",
+		Usage: usage,
+	}
+}
+
+func getRestoreFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		getQuarantineRepoFlag("[Optional] The quarantine repository from which the artifacts are restored. If not set, the " + cliutils.QuarantineRepo + " environment variable is used.` `"),
+		cli.StringFlag{
+			Name:  "deletion-id",
+			Usage: "[Optional] Restore the artifacts deleted with the specified deletion ID, as displayed by the delete command.` `",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "[Default: false] Set to true to only list the artifacts which would have been restored.` `",
+		},
+		getFailNoOpFlag(),
+	}...)
+}
+
+func getQuarantinePurgeFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		getQuarantineRepoFlag("[Optional] The quarantine repository to purge. If not set, the " + cliutils.QuarantineRepo + " environment variable is used.` `"),
+		cli.StringFlag{
+			Name:  "older-than",
+			Usage: "[Mandatory] Permanently delete the artifacts which were deleted more than the specified number of days ago. Use 0 to purge all the quarantined artifacts.` `",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "[Default: false] Set to true to disable communication with Artifactory.` `",
+		},
+		getQuiteFlag("[Default: false] Set to true to skip the delete confirmation message.` `"),
+		getFailNoOpFlag(),
+	}...)
+}
+
+func getCleanupFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.StringFlag{
+			Name:  "policy",
+			Usage: "[Mandatory] Path to a YAML retention policy file.` `",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "[Default: false] Set to true to only display the cleanup plan, without deleting anything.` `",
+		},
+		getQuiteFlag("[Default: false] Set to true to skip the delete confirmation message.` `"),
+		getFailNoOpFlag(),
+	}...)
+}
+
+func getDiffFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.BoolTFlag{
+			Name:  "recursive",
+			Usage: "[Default: true] Set to false if you do not wish to compare files inside sub-folders.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: table] The output format. Accepts 'table' or 'json'.` `",
+		},
+	}...)
+}
+
+func getLsFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.BoolFlag{
+			Name:  "long, l",
+			Usage: "[Default: false] Set to true to show the type, size and modification time of each item.` `",
+		},
+		cli.BoolFlag{
+			Name:  "recursive, R",
+			Usage: "[Default: false] Set to true to also list the items inside sub-folders.` `",
+		},
+		cli.BoolFlag{
+			Name:  "human",
+			Usage: "[Default: false] Set to true to show sizes in a human readable format, such as 1.5K or 20.3M.` `",
+		},
+		cli.BoolFlag{
+			Name:  "tree",
+			Usage: "[Default: false] Set to true to show the items inside sub-folders as a tree.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: text] The output format. Accepts 'text' or 'json'.` `",
+		},
+	}...)
+}
+
+func getStatFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.BoolFlag{
+			Name:  "human",
+			Usage: "[Default: false] Set to true to show the size in a human readable format, such as 1.5K or 20.3M.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: text] The output format. Accepts 'text' or 'json'.` `",
+		},
+	}...)
+}
+
+func getVerifyFlags() []cli.Flag {
+	verifyFlags := append(getServerFlags(), getSpecFlags()...)
+	return append(verifyFlags, []cli.Flag{
+		cli.BoolTFlag{
+			Name:  "recursive",
+			Usage: "[Default: true] Set to false if you do not wish to verify files inside sub-folders.` `",
+		},
+		cli.StringFlag{
+			Name:  "build",
+			Usage: "[Optional] Verify the local path against the artifacts and dependencies of a published build. The format is build-name/build-number. If you do not specify the build number, the latest build number is used. When used with --spec, only artifacts of the build are matched by the spec.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: table] The output format. Accepts 'table' or 'json'.` `",
+		},
 	}...)
 }
 
@@ -1033,12 +1458,29 @@ func getSearchFlags() []cli.Flag {
 			Name:  "count",
 			Usage: "[Optional] Set to true to display only the total of files or folders found.` `",
 		},
+		cli.StringFlag{
+			Name:  "fields",
+			Usage: "[Optional] Semicolon-separated list of fields to display for each result. The available fields are path, type, size, created, modified and props.` `",
+		},
+		cli.BoolFlag{
+			Name:  "sum-size",
+			Usage: "[Optional] Set to true to display only the total size in bytes of the files found.` `",
+		},
+		cli.StringFlag{
+			Name:  "group-by",
+			Usage: "[Optional] Group the results and display the number of files and their total size in bytes for each group. Accepts 'repo', 'folder', 'extension' or 'prop:<property key>'. The groups are sorted by size, largest first.` `",
+		},
+		cli.BoolFlag{
+			Name:  "tree",
+			Usage: "[Optional] Set to true to display the results as a tree, with the total size in bytes of each folder.` `",
+		},
 		getIncludeDirsFlag(),
 		getPropertiesFlag("Only artifacts with these properties will be returned."),
 		getExcludePropertiesFlag("Only artifacts without the specified properties will be returned"),
 		getFailNoOpFlag(),
 		getExcludePatternsFlag(),
 		getArchiveEntriesFlag(),
+		getThreadsFlag(),
 	}...)
 }
 
@@ -1057,6 +1499,33 @@ func getSetOrDeletePropsFlags() []cli.Flag {
 	return append(flags, getPropertiesFlags()...)
 }
 
+func getSetPropsFlags() []cli.Flag {
+	return append(getSetOrDeletePropsFlags(), cli.StringFlag{
+		Name:  "from-file",
+		Usage: "[Optional] Path to a .csv or .json file, mapping artifact patterns to the properties to be set on them. When this option is used, no arguments should be sent.` `",
+	})
+}
+
+func getPropsExportFlags() []cli.Flag {
+	flags := append(getServerFlags(), getSortLimitFlags()...)
+	flags = append(flags, getSpecFlags()...)
+	return append(flags, []cli.Flag{
+		cli.BoolTFlag{
+			Name:  "recursive",
+			Usage: "[Default: true] Set to false if you do not wish to export the properties of artifacts inside sub-folders in Artifactory.` `",
+		},
+		cli.StringFlag{
+			Name:  "build",
+			Usage: "[Optional] If specified, only artifacts of the specified build are matched. The property format is build-name/build-number. If you do not specify the build number, the artifacts are filtered by the latest build number.` `",
+		},
+		getPropertiesFlag("Only artifacts with these properties are exported."),
+		getExcludePropertiesFlag("Only artifacts without the specified properties are exported"),
+		getIncludeDirsFlag(),
+		getFailNoOpFlag(),
+		getExcludePatternsFlag(),
+	}...)
+}
+
 func getPropertiesFlag(description string) cli.Flag {
 	return cli.StringFlag{
 		Name:  "props",
@@ -1119,6 +1588,13 @@ func getThreadsFlag() cli.Flag {
 	}
 }
 
+func getLimitRateFlag() cli.Flag {
+	return cli.StringFlag{
+		Name:  "limit-rate",
+		Usage: "[Optional] Maximum transfer rate in bytes per second, shared by all threads. The rate may be followed by K, M or G, for example 512K or 10M. If not set, the rate configured for the server is used.` `",
+	}
+}
+
 func getBuildPublishFlags() []cli.Flag {
 	return append(getServerFlags(), []cli.Flag{
 		cli.StringFlag{
@@ -1137,6 +1613,154 @@ func getBuildPublishFlags() []cli.Flag {
 			Name:  "env-exclude",
 			Usage: "[Default: *password*;*secret*;*key*;*token*] List of case insensitive patterns in the form of \"value1;value2;...\". Environment variables match those patterns will be excluded.` `",
 		},
+		cli.StringFlag{
+			Name:  "output-file",
+			Usage: "[Optional] Path to a file to write the build info to, instead of publishing it to Artifactory. The build info collected locally is removed, once the file is written.` `",
+		},
+		cli.StringFlag{
+			Name:  "from-file",
+			Usage: "[Optional] Path to a build info file, written by the --output-file option, to publish instead of the build info collected locally.` `",
+		},
+		cli.BoolFlag{
+			Name:  "sign",
+			Usage: "[Default: false] Set to true to sign the build info with the --sign-key key, and upload the signature to the --signature-repo repository.` `",
+		},
+		cli.StringFlag{
+			Name:  "sign-key",
+			Usage: "[Optional] Path to the private key used with the --sign option. Either a GPG keyring file, or an ed25519 key in a PKCS #8 PEM file. If the GPG key is encrypted, its passphrase is read from the " + cliutils.SigningPassphrase + " environment variable.` `",
+		},
+		cli.StringFlag{
+			Name:  "sign-key-id",
+			Usage: "[Optional] ID or fingerprint of the signing key, if the GPG keyring includes several private keys.` `",
+		},
+		cli.StringFlag{
+			Name:  "signature-repo",
+			Usage: "[Optional] The repository the signature is uploaded to with the --sign option, under <build name>/<build number>/.` `",
+		},
+	}...)
+}
+
+func getBuildCleanFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.BoolFlag{
+			Name:  "all",
+			Usage: "[Default: false] Set to true to clean the data of all the builds, and the other data JFrog CLI keeps in its temp directory, instead of the data of one build.` `",
+		},
+		cli.StringFlag{
+			Name:  "older-than",
+			Usage: "[Optional] Clean the data of all the builds, and the other data JFrog CLI keeps in its temp directory, which is older than this age. For example 7d or 12h.` `",
+		},
+	}
+}
+
+func getBuildShowFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "build-url",
+			Usage: "[Optional] Can be used for setting the CI server build URL in the build-info.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-include",
+			Usage: "[Default: *] List of patterns in the form of \"value1;value2;...\" Only environment variables match those patterns will be included.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-exclude",
+			Usage: "[Default: *password*;*secret*;*key*;*token*] List of case insensitive patterns in the form of \"value1;value2;...\". Environment variables match those patterns will be excluded.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: json] The output format. Accepts 'json' or 'table'.` `",
+		},
+	}
+}
+
+func getBuildListLocalFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: table] The output format. Accepts 'table' or 'json'.` `",
+		},
+	}
+}
+
+func getBuildDiffFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.BoolFlag{
+			Name:  "local",
+			Usage: "[Default: false] Set to true to compare the first build with the build info collected locally for the second build, which was not published yet.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Default: text] The output format. Accepts 'text' or 'json'.` `",
+		},
+	}...)
+}
+
+func getBuildExportSbomFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.BoolFlag{
+			Name:  "local",
+			Usage: "[Default: false] Set to true to export the build info collected locally for the build, which was not published yet.` `",
+		},
+		cli.StringFlag{
+			Name:  "format",
+			Usage: "[Mandatory] The SBOM format. Accepts 'cyclonedx-json' or 'spdx-json'.` `",
+		},
+		cli.StringFlag{
+			Name:  "package-type",
+			Usage: "[Optional] The package type of dependencies identified by <name>:<version>, used in their package URLs. Accepts " + strings.Join(buildinfo.SbomPackageTypes, ", ") + ". Applies only to modules without a recorded package type, since the pip and NuGet commands record the package type of their modules.` `",
+		},
+		cli.StringFlag{
+			Name:  "build-url",
+			Usage: "[Optional] Can be used for setting the CI server build URL in the build-info of a local build.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-include",
+			Usage: "[Default: *] List of patterns in the form of \"value1;value2;...\" Only environment variables match those patterns will be included.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-exclude",
+			Usage: "[Default: *password*;*secret*;*key*;*token*] List of case insensitive patterns in the form of \"value1;value2;...\". Environment variables match those patterns will be excluded.` `",
+		},
+	}...)
+}
+
+func getBuildAppendFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.StringFlag{
+			Name:  "as",
+			Usage: "[Default: module] Set to 'module' to add the artifacts of the appended build as a module, or to 'dependency' to add them as dependencies.` `",
+		},
+		cli.StringFlag{
+			Name:  "module",
+			Usage: "[Optional] ID of the module the appended build is added to. By default, a module is named <build name to append>/<build number to append>, and dependencies are added to the module named after the build.` `",
+		},
+	}...)
+}
+
+func getBuildVerifySignatureFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.StringFlag{
+			Name:  "signature-repo",
+			Usage: "[Mandatory] The repository the signature was uploaded to by the build-publish command.` `",
+		},
+		cli.StringFlag{
+			Name:  "public-key",
+			Usage: "[Mandatory] Path to the public key used for verifying the signature. Either a GPG keyring file, or an ed25519 key in a PEM file.` `",
+		},
+	}...)
+}
+
+func getBuildMergeFlags() []cli.Flag {
+	return append(getServerFlags(), []cli.Flag{
+		cli.StringFlag{
+			Name:  "on-conflict",
+			Usage: "[Default: fail] What to do with modules which have the same ID in several merged builds. Set to 'fail' to fail the command, 'rename' to rename them to <build name>/<build number>/<module ID>, or 'merge' to merge them into one module.` `",
+		},
+		cli.BoolFlag{
+			Name:  "dry-run",
+			Usage: "[Default: false] Set to true to print the merged build info, without publishing it.` `",
+		},
 	}...)
 }
 
@@ -1244,6 +1868,10 @@ func getConfigFlags() []cli.Flag {
 			Name:  "enc-password",
 			Usage: "[Default: true] If set to false then the configured password will not be encrypted using Artifatory's encryption API.` `",
 		},
+		cli.StringFlag{
+			Name:  "limit-rate",
+			Usage: "[Optional] Default maximum transfer rate in bytes per second for uploads and downloads from this server. The rate may be followed by K, M or G, for example 512K or 10M.` `",
+		},
 	}
 	flags = append(flags, getBaseFlags()...)
 	return append(flags,
@@ -1293,11 +1921,32 @@ func getBuildScanFlags() []cli.Flag {
 	}...)
 }
 
+func getBuildCollectEnvFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:  "env-include",
+			Usage: "[Default: *] List of patterns in the form of \"value1;value2;...\" Only environment variables match those patterns will be collected.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-exclude",
+			Usage: "[Default: *password*;*secret*;*key*;*token*] List of case insensitive patterns in the form of \"value1;value2;...\". Environment variables match those patterns will not be collected.` `",
+		},
+	}
+}
+
 func getBuildAddGitFlags() []cli.Flag {
 	return []cli.Flag{
 		cli.StringFlag{
 			Name:  "config",
-			Usage: "[Optional] Path to a configuration file.` `",
+			Usage: "[Optional] Path to a configuration file, for collecting the issues referenced by the commits. The file may list several issue trackers under issues.trackers. issues.logLimit limits the number of commits read, also when the previous build's revision is known. 0 means no limit.` `",
+		},
+		cli.StringFlag{
+			Name:  "remote",
+			Usage: "[Optional] The git remote whose URL is added to the build. If not specified, the remote tracked by the current branch is used, or origin.` `",
+		},
+		cli.StringFlag{
+			Name:  "env-exclude",
+			Usage: "[Default: *password*;*secret*;*key*;*token*] List of case insensitive patterns in the form of \"value1;value2;...\". VCS properties match those patterns will not be collected.` `",
 		},
 	}
 }
@@ -1530,7 +2179,11 @@ func dockerPushCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	dockerPushCommand.SetThreads(threads).SetBuildConfiguration(buildConfiguration).SetRepo(targetRepo).SetSkipLogin(skipLogin).SetRtDetails(artDetails).SetImageTag(imageTag)
+	limitRate, err := ratelimit.GetLimitRate(artDetails.LimitRate)
+	if err != nil {
+		return err
+	}
+	dockerPushCommand.SetThreads(threads).SetLimitRate(limitRate).SetBuildConfiguration(buildConfiguration).SetRepo(targetRepo).SetSkipLogin(skipLogin).SetRtDetails(artDetails).SetImageTag(imageTag)
 
 	return commands.Exec(dockerPushCommand)
 }
@@ -1853,6 +2506,10 @@ func downloadCmd(c *cli.Context) error {
 	if err != nil {
 		return nil
 	}
+	configuration.LimitRate, err = ratelimit.GetLimitRate(rtDetails.LimitRate)
+	if err != nil {
+		return err
+	}
 	buildConfiguration, err := createBuildToolConfiguration(c)
 	if err != nil {
 		return nil
@@ -1903,6 +2560,17 @@ func uploadCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	configuration.LimitRate, err = ratelimit.GetLimitRate(rtDetails.LimitRate)
+	if err != nil {
+		return err
+	}
+	if c.Bool("watch") {
+		watchInterval, err := cliutils.GetIntFlagValue(c, "watch-interval", cliutils.WatchIntervalSeconds)
+		if err != nil || watchInterval < 1 {
+			return cliutils.PrintHelpAndReturnError("The --watch-interval option should have a numeric positive value.", c)
+		}
+		uploadCmd.SetWatchInterval(time.Duration(watchInterval) * time.Second)
+	}
 	uploadCmd.SetUploadConfiguration(configuration).SetBuildConfiguration(buildConfiguration).SetSpec(uploadSpec).SetRtDetails(rtDetails).SetDryRun(c.Bool("dry-run")).SetSyncDeletesPath(c.String("sync-deletes")).SetQuiet(c.Bool("quiet"))
 	err = commands.Exec(uploadCmd)
 	defer logUtils.CloseLogFile(uploadCmd.LogFile())
@@ -1934,13 +2602,23 @@ func moveCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	err = generic.ValidateOnConflict(c.String("on-conflict"))
+	if err != nil {
+		return err
+	}
+	threads, err := getThreadsCount(c)
+	if err != nil {
+		return err
+	}
 	moveCmd := generic.NewMoveCommand()
 	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
 	if err != nil {
 		return err
 	}
-	moveCmd.SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails).SetSpec(moveSpec)
+	moveCmd.SetOnConflict(c.String("on-conflict")).SetVerify(c.Bool("verify"))
+	moveCmd.SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails).SetSpec(moveSpec).SetThreads(threads)
 	err = commands.Exec(moveCmd)
+	printVerificationDiscrepancies(moveCmd.Discrepancies())
 	result := moveCmd.Result()
 	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
 
@@ -1970,19 +2648,96 @@ func copyCmd(c *cli.Context) error {
 		return err
 	}
 
+	err = generic.ValidateOnConflict(c.String("on-conflict"))
+	if err != nil {
+		return err
+	}
+	threads, err := getThreadsCount(c)
+	if err != nil {
+		return err
+	}
 	copyCommand := generic.NewCopyCommand()
 	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
 	if err != nil {
 		return err
 	}
-	copyCommand.SetSpec(copySpec).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails)
+	copyCommand.SetOnConflict(c.String("on-conflict")).SetVerify(c.Bool("verify"))
+	copyCommand.SetSpec(copySpec).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails).SetThreads(threads)
 	err = commands.Exec(copyCommand)
+	printVerificationDiscrepancies(copyCommand.Discrepancies())
 	result := copyCommand.Result()
 	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
 
 	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
 }
 
+func printVerificationDiscrepancies(discrepancies []generic.VerificationDiscrepancy) {
+	if len(discrepancies) == 0 {
+		return
+	}
+	content, err := json.MarshalIndent(discrepancies, "", "  ")
+	if err != nil {
+		log.Error(err)
+		return
+	}
+	log.Output(string(content))
+}
+
+func transferCmd(c *cli.Context) error {
+	if c.NArg() > 0 && c.IsSet("spec") {
+		return cliutils.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
+	}
+	if !(c.NArg() == 2 || (c.NArg() == 0 && (c.IsSet("spec") || c.IsSet("build")))) {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	if c.String("source-server-id") == "" || c.String("target-server-id") == "" {
+		return cliutils.PrintHelpAndReturnError("The --source-server-id and --target-server-id options are mandatory.", c)
+	}
+
+	var transferSpec *spec.SpecFiles
+	var err error
+	if c.IsSet("spec") {
+		transferSpec, err = getSearchSpec(c)
+	} else {
+		transferSpec, err = createDefaultCopyMoveSpec(c)
+	}
+	if err != nil {
+		return err
+	}
+	err = spec.ValidateSpec(transferSpec.Files, true, true)
+	if err != nil {
+		return err
+	}
+
+	sourceRtDetails, err := getRtDetailsByServerId(c.String("source-server-id"))
+	if err != nil {
+		return err
+	}
+	targetRtDetails, err := getRtDetailsByServerId(c.String("target-server-id"))
+	if err != nil {
+		return err
+	}
+	transferCommand := generic.NewTransferCommand()
+	transferCommand.SetTargetRtDetails(targetRtDetails).SetSpec(transferSpec).SetDryRun(c.Bool("dry-run")).SetRtDetails(sourceRtDetails)
+	err = commands.Exec(transferCommand)
+	result := transferCommand.Result()
+	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
+
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
+func getRtDetailsByServerId(serverId string) (*config.ArtifactoryDetails, error) {
+	details, err := commands.GetConfig(serverId)
+	if err != nil {
+		return nil, err
+	}
+	if details.Url == "" {
+		return nil, errors.New("The Artifactory server ID '" + serverId + "' is not configured.")
+	}
+	details.Url = clientutils.AddTrailingSlashIfNeeded(details.Url)
+	return details, nil
+}
+
 func deleteCmd(c *cli.Context) error {
 	if c.NArg() > 0 && c.IsSet("spec") {
 		return cliutils.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
@@ -2006,12 +2761,16 @@ func deleteCmd(c *cli.Context) error {
 		return err
 	}
 
+	threads, err := getThreadsCount(c)
+	if err != nil {
+		return err
+	}
 	deleteCommand := generic.NewDeleteCommand()
 	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
 	if err != nil {
 		return err
 	}
-	deleteCommand.SetQuiet(c.Bool("quiet")).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails).SetSpec(deleteSpec)
+	deleteCommand.SetQuarantineRepo(getQuarantineRepo(c)).SetQuiet(c.Bool("quiet")).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails).SetSpec(deleteSpec).SetThreads(threads)
 	err = commands.Exec(deleteCommand)
 	result := deleteCommand.Result()
 	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
@@ -2019,6 +2778,88 @@ func deleteCmd(c *cli.Context) error {
 	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
 }
 
+func restoreCmd(c *cli.Context) error {
+	if c.NArg() > 0 && c.IsSet("deletion-id") {
+		return cliutils.PrintHelpAndReturnError("No arguments should be sent when the deletion-id option is used.", c)
+	}
+	if !(c.NArg() == 1 || (c.NArg() == 0 && c.IsSet("deletion-id"))) {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	quarantineRepo := getQuarantineRepo(c)
+	if quarantineRepo == "" {
+		return cliutils.PrintHelpAndReturnError("The --quarantine-repo option or the "+cliutils.QuarantineRepo+" environment variable must be set.", c)
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	restoreCommand := generic.NewRestoreCommand()
+	restoreCommand.SetQuarantineRepo(quarantineRepo).SetDeletionId(c.String("deletion-id")).SetPattern(c.Args().Get(0))
+	restoreCommand.SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails)
+	err = commands.Exec(restoreCommand)
+	result := restoreCommand.Result()
+	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
+
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
+func quarantinePurgeCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	quarantineRepo := getQuarantineRepo(c)
+	if quarantineRepo == "" {
+		return cliutils.PrintHelpAndReturnError("The --quarantine-repo option or the "+cliutils.QuarantineRepo+" environment variable must be set.", c)
+	}
+	if !c.IsSet("older-than") {
+		return cliutils.PrintHelpAndReturnError("The --older-than option is mandatory.", c)
+	}
+	olderThan, err := strconv.Atoi(c.String("older-than"))
+	if err != nil || olderThan < 0 {
+		return cliutils.PrintHelpAndReturnError("The --older-than option should have a non-negative numeric value.", c)
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	purgeCommand := generic.NewQuarantinePurgeCommand()
+	purgeCommand.SetQuarantineRepo(quarantineRepo).SetOlderThanDays(olderThan)
+	purgeCommand.SetQuiet(c.Bool("quiet")).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails)
+	err = commands.Exec(purgeCommand)
+	result := purgeCommand.Result()
+	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
+
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
+// Returns the quarantine repository from the --quarantine-repo option, or from the environment if the option is not set.
+func getQuarantineRepo(c *cli.Context) string {
+	if c.String("quarantine-repo") != "" {
+		return c.String("quarantine-repo")
+	}
+	return os.Getenv(cliutils.QuarantineRepo)
+}
+
+func cleanupCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	if c.String("policy") == "" {
+		return cliutils.PrintHelpAndReturnError("The --policy option is mandatory.", c)
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	cleanupCommand := generic.NewCleanupCommand()
+	cleanupCommand.SetPolicyPath(c.String("policy")).SetQuiet(c.Bool("quiet")).SetDryRun(c.Bool("dry-run")).SetRtDetails(rtDetails)
+	err = commands.Exec(cleanupCommand)
+	result := cleanupCommand.Result()
+	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
+
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
 func searchCmd(c *cli.Context) error {
 	if c.NArg() > 0 && c.IsSet("spec") {
 		return cliutils.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
@@ -2041,36 +2882,256 @@ func searchCmd(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
+	err = validateSearchOutputFlags(c)
+	if err != nil {
+		return err
+	}
+	threads, err := getThreadsCount(c)
+	if err != nil {
+		return err
+	}
 	artDetails, err := createArtifactoryDetailsByFlags(c, true)
 	if err != nil {
 		return err
 	}
 	searchCmd := generic.NewSearchCommand()
-	searchCmd.SetRtDetails(artDetails).SetSpec(searchSpec)
+	searchCmd.SetRtDetails(artDetails).SetSpec(searchSpec).SetThreads(threads)
 	err = commands.Exec(searchCmd)
 	if err != nil {
 		return err
 	}
-	result, err := json.Marshal(searchCmd.SearchResult())
-	err = cliutils.GetCliError(err, len(searchCmd.SearchResult()), 0, isFailNoOp(c))
+	err = cliutils.GetCliError(nil, len(searchCmd.SearchResult()), 0, isFailNoOp(c))
 	if err != nil {
 		return err
 	}
-	if c.Bool("count") {
-		log.Output(len(searchCmd.SearchResult()))
-	} else {
-		log.Output(string(clientutils.IndentJson(result)))
-	}
-
-	return err
+	return printSearchResult(c, searchCmd.SearchResult())
 }
 
-func preparePropsCmd(c *cli.Context) (*generic.PropsCommand, error) {
-	if c.NArg() > 1 && c.IsSet("spec") {
-		return nil, cliutils.PrintHelpAndReturnError("Only the 'artifact properties' argument should be sent when the spec option is used.", c)
+func validateSearchOutputFlags(c *cli.Context) error {
+	outputFlags := 0
+	for _, flag := range []string{"count", "sum-size", "tree"} {
+		if c.Bool(flag) {
+			outputFlags++
+		}
 	}
-	if !(c.NArg() == 2 || (c.NArg() == 1 && c.IsSet("spec"))) {
-		return nil, cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	if c.String("group-by") != "" {
+		outputFlags++
+		if err := generic.ValidateGroupBy(c.String("group-by")); err != nil {
+			return err
+		}
+	}
+	if outputFlags > 1 {
+		return cliutils.PrintHelpAndReturnError("Only one of the --count, --sum-size, --group-by and --tree options can be used.", c)
+	}
+	if outputFlags > 0 && c.String("fields") != "" {
+		return cliutils.PrintHelpAndReturnError("The --fields option cannot be used with the --count, --sum-size, --group-by and --tree options.", c)
+	}
+	return nil
+}
+
+func printSearchResult(c *cli.Context, searchResult []generic.SearchResult) error {
+	var output interface{} = searchResult
+	switch {
+	case c.Bool("count"):
+		log.Output(len(searchResult))
+		return nil
+	case c.Bool("sum-size"):
+		log.Output(generic.SumSearchResultsSize(searchResult))
+		return nil
+	case c.Bool("tree"):
+		log.Output(generic.CreateSearchResultTree(searchResult))
+		return nil
+	case c.String("group-by") != "":
+		groups, err := generic.GroupSearchResults(searchResult, c.String("group-by"))
+		if err != nil {
+			return err
+		}
+		output = groups
+	case c.String("fields") != "":
+		selected, err := generic.SelectSearchResultFields(searchResult, cliutils.GetStringsArrFlagValue(c, "fields"))
+		if err != nil {
+			return err
+		}
+		output = selected
+	}
+	result, err := json.Marshal(output)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(clientutils.IndentJson(result)))
+	return nil
+}
+
+func diffCmd(c *cli.Context) error {
+	if c.NArg() != 2 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "table" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'table' or 'json'.", c)
+	}
+	artDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	diffCmd := generic.NewDiffCommand()
+	diffCmd.SetLocalPath(c.Args().Get(0)).SetRepoPath(c.Args().Get(1)).SetRecursive(c.BoolT("recursive")).SetRtDetails(artDetails)
+	err = commands.Exec(diffCmd)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		result, err := json.Marshal(diffCmd.DiffResult())
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+	} else if len(diffCmd.DiffResult()) > 0 {
+		log.Output(generic.CreateDiffTable(diffCmd.DiffResult()))
+	}
+	if len(diffCmd.DiffResult()) > 0 {
+		return cliutils.CliError{ExitCode: cliutils.ExitCodeError, ErrorMsg: fmt.Sprintf("Found %d differences between '%s' and '%s'.", len(diffCmd.DiffResult()), c.Args().Get(0), c.Args().Get(1))}
+	}
+	return nil
+}
+
+func verifyCmd(c *cli.Context) error {
+	if c.NArg() > 0 && c.IsSet("spec") {
+		return cliutils.PrintHelpAndReturnError("No arguments should be sent when the spec option is used.", c)
+	}
+	if !(c.IsSet("spec") || (c.IsSet("build") && c.NArg() == 1) || (!c.IsSet("build") && c.NArg() == 2)) {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "table" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'table' or 'json'.", c)
+	}
+	artDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	verifyCmd := generic.NewVerifyCommand()
+	verifyCmd.SetRecursive(c.BoolT("recursive"))
+	switch {
+	case c.IsSet("spec"):
+		verifySpec, err := getSearchSpec(c)
+		if err != nil {
+			return err
+		}
+		if err = spec.ValidateSpec(verifySpec.Files, false, true); err != nil {
+			return err
+		}
+		verifyCmd.SetSpec(verifySpec)
+	case c.IsSet("build"):
+		buildName, buildNumber := splitBuildNameAndNumber(c.String("build"))
+		verifyCmd.SetBuild(buildName, buildNumber).SetLocalPath(c.Args().Get(0))
+	default:
+		verifyCmd.SetLocalPath(c.Args().Get(0)).SetRepoPath(c.Args().Get(1))
+	}
+	verifyCmd.SetRtDetails(artDetails)
+	err = commands.Exec(verifyCmd)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		result, err := json.Marshal(verifyCmd.VerifyResult())
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+	} else if len(verifyCmd.VerifyResult()) > 0 {
+		log.Output(generic.CreateVerifyTable(verifyCmd.VerifyResult()))
+	}
+	if len(verifyCmd.VerifyResult()) > 0 {
+		return cliutils.CliError{ExitCode: cliutils.ExitCodeError, ErrorMsg: fmt.Sprintf("Found %d missing, extra or corrupted files.", len(verifyCmd.VerifyResult()))}
+	}
+	return nil
+}
+
+// Splits a build in the form of build-name/build-number. If the build number is not specified, the latest build is used.
+func splitBuildNameAndNumber(build string) (string, string) {
+	i := strings.LastIndex(build, "/")
+	if i < 0 {
+		return build, "LATEST"
+	}
+	return build[:i], build[i+1:]
+}
+
+func lsCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "text" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'text' or 'json'.", c)
+	}
+	artDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	lsCmd := generic.NewListCommand()
+	// The tree view shows the items inside sub-folders.
+	lsCmd.SetRepoPath(c.Args().Get(0)).SetRecursive(c.Bool("recursive") || c.Bool("tree")).SetRtDetails(artDetails)
+	err = commands.Exec(lsCmd)
+	if err != nil {
+		return err
+	}
+	items := lsCmd.Items()
+	switch {
+	case format == "json":
+		result, err := json.Marshal(items)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+	case c.Bool("tree"):
+		log.Output(generic.CreateListTree(strings.Trim(c.Args().Get(0), "/"), items, c.Bool("long"), c.Bool("human")))
+	case c.Bool("long") && len(items) > 0:
+		log.Output(generic.CreateListTable(items, c.Bool("human")))
+	default:
+		for _, item := range items {
+			log.Output(generic.FormatListItemPath(item))
+		}
+	}
+	return nil
+}
+
+func statCmd(c *cli.Context) error {
+	if c.NArg() != 1 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "text" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'text' or 'json'.", c)
+	}
+	artDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	statCmd := generic.NewStatCommand()
+	statCmd.SetRepoPath(c.Args().Get(0)).SetRtDetails(artDetails)
+	err = commands.Exec(statCmd)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		result, err := json.Marshal(statCmd.ItemStat())
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+		return nil
+	}
+	log.Output(generic.CreateStatOutput(statCmd.ItemStat(), c.Bool("human")))
+	return nil
+}
+
+func preparePropsCmd(c *cli.Context) (*generic.PropsCommand, error) {
+	if c.NArg() > 1 && c.IsSet("spec") {
+		return nil, cliutils.PrintHelpAndReturnError("Only the 'artifact properties' argument should be sent when the spec option is used.", c)
+	}
+	if !(c.NArg() == 2 || (c.NArg() == 1 && c.IsSet("spec"))) {
+		return nil, cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
 	}
 
 	var propsSpec *spec.SpecFiles
@@ -2107,6 +3168,9 @@ func preparePropsCmd(c *cli.Context) (*generic.PropsCommand, error) {
 }
 
 func setPropsCmd(c *cli.Context) error {
+	if c.IsSet("from-file") {
+		return setPropsFromFileCmd(c)
+	}
 	cmd, err := preparePropsCmd(c)
 	if err != nil {
 		return err
@@ -2120,6 +3184,69 @@ func setPropsCmd(c *cli.Context) error {
 	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
 }
 
+func setPropsFromFileCmd(c *cli.Context) error {
+	if c.NArg() > 0 || c.IsSet("spec") {
+		return cliutils.PrintHelpAndReturnError("No arguments or spec should be sent when the from-file option is used.", c)
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	threads, err := getThreadsCount(c)
+	if err != nil {
+		return err
+	}
+	propsCmd := generic.NewSetPropsFromFileCommand().SetMappingFile(c.String("from-file"))
+	propsCmd.SetThreads(threads).SetRtDetails(rtDetails)
+	err = commands.Exec(propsCmd)
+	if len(propsCmd.MappingResult()) > 0 {
+		report, marshalErr := json.Marshal(propsCmd.MappingResult())
+		if marshalErr == nil {
+			log.Output(string(clientutils.IndentJson(report)))
+		}
+	}
+	result := propsCmd.Result()
+	err = cliutils.PrintSummaryReport(result.SuccessCount(), result.FailCount(), err)
+
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
+func propsExportCmd(c *cli.Context) error {
+	if c.NArg() > 1 && c.IsSet("spec") {
+		return cliutils.PrintHelpAndReturnError("Only the 'mapping file path' argument should be sent when the spec option is used.", c)
+	}
+	if !(c.NArg() == 2 || (c.NArg() == 1 && c.IsSet("spec"))) {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+
+	var exportSpec *spec.SpecFiles
+	var err error
+	var outputFile string
+	if c.IsSet("spec") {
+		outputFile = c.Args()[0]
+		exportSpec, err = getSearchSpec(c)
+	} else {
+		outputFile = c.Args()[1]
+		exportSpec, err = createDefaultSearchSpec(c)
+	}
+	if err != nil {
+		return err
+	}
+	err = spec.ValidateSpec(exportSpec.Files, false, true)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	exportCmd := generic.NewPropsExportCommand().SetOutputFile(outputFile)
+	exportCmd.SetSpec(exportSpec).SetRtDetails(rtDetails)
+	err = commands.Exec(exportCmd)
+	result := exportCmd.Result()
+	return cliutils.GetCliError(err, result.SuccessCount(), result.FailCount(), isFailNoOp(c))
+}
+
 func deletePropsCmd(c *cli.Context) error {
 	cmd, err := preparePropsCmd(c)
 	if err != nil {
@@ -2138,16 +3265,40 @@ func buildPublishCmd(c *cli.Context) error {
 	if c.NArg() > 2 {
 		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
 	}
+	if c.IsSet("output-file") && c.IsSet("from-file") {
+		return cliutils.PrintHelpAndReturnError("The --output-file and --from-file options cannot be used together.", c)
+	}
 	buildConfiguration := createBuildConfiguration(c)
-	if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
-		return err
+	// When publishing from a file, the build name and number are read from the file.
+	if !c.IsSet("from-file") {
+		if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
+			return err
+		}
+	}
+	if c.Bool("sign") {
+		if c.IsSet("output-file") {
+			return cliutils.PrintHelpAndReturnError("The --sign option cannot be used with the --output-file option, since the build info is not published.", c)
+		}
+		if c.String("sign-key") == "" || c.String("signature-repo") == "" {
+			return cliutils.PrintHelpAndReturnError("The --sign option requires the --sign-key and --signature-repo options.", c)
+		}
+	} else if c.IsSet("sign-key") || c.IsSet("sign-key-id") || c.IsSet("signature-repo") {
+		return cliutils.PrintHelpAndReturnError("The --sign-key, --sign-key-id and --signature-repo options can only be used with the --sign option.", c)
 	}
 	buildInfoConfiguration := createBuildInfoConfiguration(c)
-	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
-	if err != nil {
-		return err
+	buildPublishCmd := buildinfo.NewBuildPublishCommand().SetBuildConfiguration(buildConfiguration).SetConfig(buildInfoConfiguration).
+		SetOutputFile(c.String("output-file")).SetFromFile(c.String("from-file"))
+	if c.Bool("sign") {
+		buildPublishCmd.SetSigningKeyPath(c.String("sign-key")).SetSigningKeyId(c.String("sign-key-id")).SetSignatureRepo(c.String("signature-repo"))
+	}
+	// Writing the build info to a file requires no Artifactory server.
+	if !c.IsSet("output-file") {
+		rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+		if err != nil {
+			return err
+		}
+		buildPublishCmd.SetRtDetails(rtDetails)
 	}
-	buildPublishCmd := buildinfo.NewBuildPublishCommand().SetRtDetails(rtDetails).SetBuildConfiguration(buildConfiguration).SetConfig(buildInfoConfiguration)
 
 	return commands.Exec(buildPublishCmd)
 }
@@ -2196,7 +3347,7 @@ func buildCollectEnvCmd(c *cli.Context) error {
 	if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
 		return err
 	}
-	buildCollectEnvCmd := buildinfo.NewBuildCollectEnvCommand().SetBuildConfiguration(buildConfiguration)
+	buildCollectEnvCmd := buildinfo.NewBuildCollectEnvCommand().SetBuildConfiguration(buildConfiguration).SetConfig(createBuildInfoConfiguration(c))
 
 	return commands.Exec(buildCollectEnvCmd)
 }
@@ -2210,7 +3361,8 @@ func buildAddGitCmd(c *cli.Context) error {
 		return err
 	}
 
-	buildAddGitConfigurationCmd := buildinfo.NewBuildAddGitCommand().SetBuildConfiguration(buildConfiguration).SetConfigFilePath(c.String("config"))
+	buildAddGitConfigurationCmd := buildinfo.NewBuildAddGitCommand().SetBuildConfiguration(buildConfiguration).SetConfigFilePath(c.String("config")).SetRemote(c.String("remote")).
+		SetEnvExclude(createBuildInfoConfiguration(c).EnvExclude)
 	if c.NArg() == 3 {
 		buildAddGitConfigurationCmd.SetDotGitPath(c.Args().Get(2))
 	} else if c.NArg() == 1 {
@@ -2238,6 +3390,9 @@ func buildScanCmd(c *cli.Context) error {
 }
 
 func buildCleanCmd(c *cli.Context) error {
+	if c.Bool("all") || c.IsSet("older-than") {
+		return buildCleanAllCmd(c)
+	}
 	if c.NArg() > 2 {
 		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
 	}
@@ -2250,6 +3405,229 @@ func buildCleanCmd(c *cli.Context) error {
 	return commands.Exec(buildCleanCmd)
 }
 
+func buildCleanAllCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("No arguments are expected with the --all and --older-than options.", c)
+	}
+	var olderThan time.Duration
+	if c.IsSet("older-than") {
+		var err error
+		if olderThan, err = utils.ParseAge(c.String("older-than")); err != nil {
+			return err
+		}
+	}
+	buildCleanCmd := buildinfo.NewBuildCleanCommand().SetAll(true).SetOlderThan(olderThan)
+	err := commands.Exec(buildCleanCmd)
+	removed := buildCleanCmd.Removed()
+	if len(removed) > 0 {
+		log.Output(buildinfo.CreateRemovedTempDataTable(removed, time.Now()))
+	} else if err == nil {
+		log.Info("No build data to clean.")
+	}
+	return err
+}
+
+func buildShowCmd(c *cli.Context) error {
+	if c.NArg() > 2 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "json" && format != "table" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'json' or 'table'.", c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
+		return err
+	}
+	buildShowCmd := buildinfo.NewBuildShowCommand().SetBuildConfiguration(buildConfiguration).SetConfig(createBuildInfoConfiguration(c))
+	err := commands.Exec(buildShowCmd)
+	if err != nil {
+		return err
+	}
+	if format == "table" {
+		log.Output(buildinfo.CreateBuildInfoTables(buildShowCmd.BuildInfo()))
+		return nil
+	}
+	result, err := json.Marshal(buildShowCmd.BuildInfo())
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	log.Output(string(clientutils.IndentJson(result)))
+	return nil
+}
+
+func buildListLocalCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "table" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'table' or 'json'.", c)
+	}
+	buildListLocalCmd := buildinfo.NewBuildListLocalCommand()
+	err := commands.Exec(buildListLocalCmd)
+	if err != nil {
+		return err
+	}
+	localBuilds := buildListLocalCmd.LocalBuilds()
+	if format == "json" {
+		if localBuilds == nil {
+			localBuilds = []utils.LocalBuild{}
+		}
+		result, err := json.Marshal(localBuilds)
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+		return nil
+	}
+	if len(localBuilds) == 0 {
+		log.Info("No build info was collected locally.")
+		return nil
+	}
+	log.Output(buildinfo.CreateLocalBuildsTable(localBuilds, time.Now()))
+	return nil
+}
+
+func buildDiffCmd(c *cli.Context) error {
+	if c.NArg() != 3 && c.NArg() != 4 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	format := c.String("format")
+	if format != "" && format != "text" && format != "json" {
+		return cliutils.PrintHelpAndReturnError("The --format option accepts 'text' or 'json'.", c)
+	}
+	from := &utils.BuildConfiguration{BuildName: c.Args().Get(0), BuildNumber: c.Args().Get(1)}
+	to := &utils.BuildConfiguration{BuildName: c.Args().Get(0), BuildNumber: c.Args().Get(2)}
+	if c.NArg() == 4 {
+		to = &utils.BuildConfiguration{BuildName: c.Args().Get(2), BuildNumber: c.Args().Get(3)}
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	buildDiffCmd := buildinfo.NewBuildDiffCommand().SetRtDetails(rtDetails).SetBuilds(from, to).SetToLocal(c.Bool("local")).SetConfig(createBuildInfoConfiguration(c))
+	err = commands.Exec(buildDiffCmd)
+	if err != nil {
+		return err
+	}
+	if format == "json" {
+		result, err := json.Marshal(buildDiffCmd.Diff())
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+		log.Output(string(clientutils.IndentJson(result)))
+		return nil
+	}
+	log.Output(buildinfo.CreateBuildDiffOutput(buildDiffCmd.Diff()))
+	return nil
+}
+
+func buildExportSbomCmd(c *cli.Context) error {
+	if c.NArg() > 2 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
+		return err
+	}
+	format := c.String("format")
+	if format != buildinfo.SbomFormatCycloneDx && format != buildinfo.SbomFormatSpdx {
+		return cliutils.PrintHelpAndReturnError("The --format option is mandatory and accepts '"+buildinfo.SbomFormatCycloneDx+"' or '"+buildinfo.SbomFormatSpdx+"'.", c)
+	}
+	packageType := c.String("package-type")
+	if packageType != "" && !buildinfo.IsSbomPackageType(packageType) {
+		return cliutils.PrintHelpAndReturnError("The --package-type option accepts "+strings.Join(buildinfo.SbomPackageTypes, ", ")+".", c)
+	}
+	buildExportSbomCmd := buildinfo.NewBuildExportSbomCommand().SetBuildConfiguration(buildConfiguration).SetLocal(c.Bool("local")).
+		SetConfig(createBuildInfoConfiguration(c)).SetFormat(format).SetPackageType(packageType)
+	if !c.Bool("local") {
+		rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+		if err != nil {
+			return err
+		}
+		buildExportSbomCmd.SetRtDetails(rtDetails)
+	}
+	err := commands.Exec(buildExportSbomCmd)
+	if err != nil {
+		return err
+	}
+	log.Output(string(buildExportSbomCmd.Sbom()))
+	return nil
+}
+
+func buildAppendCmd(c *cli.Context) error {
+	if c.NArg() != 4 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	appendAs := c.String("as")
+	if appendAs == "" {
+		appendAs = buildinfo.AppendAsModule
+	}
+	if appendAs != buildinfo.AppendAsModule && appendAs != buildinfo.AppendAsDependency {
+		return cliutils.PrintHelpAndReturnError("The --as option accepts '"+buildinfo.AppendAsModule+"' or '"+buildinfo.AppendAsDependency+"'.", c)
+	}
+	buildConfiguration := &utils.BuildConfiguration{BuildName: c.Args().Get(0), BuildNumber: c.Args().Get(1)}
+	appendedBuild := &utils.BuildConfiguration{BuildName: c.Args().Get(2), BuildNumber: c.Args().Get(3)}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	buildAppendCmd := buildinfo.NewBuildAppendCommand().SetRtDetails(rtDetails).SetBuildConfiguration(buildConfiguration).
+		SetAppendedBuild(appendedBuild).SetAppendAs(appendAs).SetModuleId(c.String("module"))
+	return commands.Exec(buildAppendCmd)
+}
+
+func buildMergeCmd(c *cli.Context) error {
+	if c.NArg() < 3 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	onConflict := c.String("on-conflict")
+	if onConflict == "" {
+		onConflict = buildinfo.MergeConflictFail
+	}
+	if onConflict != buildinfo.MergeConflictFail && onConflict != buildinfo.MergeConflictRename && onConflict != buildinfo.MergeConflictMerge {
+		return cliutils.PrintHelpAndReturnError("The --on-conflict option accepts '"+buildinfo.MergeConflictFail+"', '"+buildinfo.MergeConflictRename+"' or '"+buildinfo.MergeConflictMerge+"'.", c)
+	}
+	buildConfiguration := &utils.BuildConfiguration{BuildName: c.Args().Get(0), BuildNumber: c.Args().Get(1)}
+	var mergedBuilds []*utils.BuildConfiguration
+	for _, mergedBuild := range c.Args()[2:] {
+		// Build names may include slashes, but build numbers may not.
+		i := strings.LastIndex(mergedBuild, "/")
+		if i <= 0 || i == len(mergedBuild)-1 {
+			return cliutils.PrintHelpAndReturnError("The merged build "+mergedBuild+" should be in the form of <build name>/<build number>.", c)
+		}
+		mergedBuilds = append(mergedBuilds, &utils.BuildConfiguration{BuildName: mergedBuild[:i], BuildNumber: mergedBuild[i+1:]})
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	buildMergeCmd := buildinfo.NewBuildMergeCommand().SetRtDetails(rtDetails).SetBuildConfiguration(buildConfiguration).
+		SetMergedBuilds(mergedBuilds).SetOnConflict(onConflict).SetDryRun(c.Bool("dry-run"))
+	return commands.Exec(buildMergeCmd)
+}
+
+func buildVerifySignatureCmd(c *cli.Context) error {
+	if c.NArg() > 2 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	if c.String("signature-repo") == "" || c.String("public-key") == "" {
+		return cliutils.PrintHelpAndReturnError("The --signature-repo and --public-key options are mandatory.", c)
+	}
+	buildConfiguration := createBuildConfiguration(c)
+	if err := validateBuildConfiguration(c, buildConfiguration); err != nil {
+		return err
+	}
+	rtDetails, err := createArtifactoryDetailsByFlags(c, true)
+	if err != nil {
+		return err
+	}
+	buildVerifySignatureCmd := buildinfo.NewBuildVerifySignatureCommand().SetRtDetails(rtDetails).SetBuildConfiguration(buildConfiguration).
+		SetSignatureRepo(c.String("signature-repo")).SetPublicKeyPath(c.String("public-key"))
+	return commands.Exec(buildVerifySignatureCmd)
+}
+
 func buildPromoteCmd(c *cli.Context) error {
 	if c.NArg() > 3 {
 		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
@@ -2436,6 +3814,7 @@ func createArtifactoryDetails(c *cli.Context, includeConfig bool) (details *conf
 	details.AccessToken = c.String("access-token")
 	details.ServerId = c.String("server-id")
 	details.InsecureTls = c.Bool("insecure-tls")
+	details.LimitRate = c.String("limit-rate")
 
 	if details.ApiKey != "" && details.User != "" && details.Password == "" {
 		// The API Key is deprecated, use password option instead.
@@ -2452,6 +3831,9 @@ func createArtifactoryDetails(c *cli.Context, includeConfig bool) (details *conf
 		if details.Url == "" {
 			details.Url = confDetails.Url
 		}
+		if details.LimitRate == "" {
+			details.LimitRate = confDetails.LimitRate
+		}
 
 		if !isAuthMethodSet(details) {
 			if details.ApiKey == "" {
@@ -2718,6 +4100,7 @@ func createDownloadConfiguration(c *cli.Context) (downloadConfiguration *utils.D
 		return nil, err
 	}
 	downloadConfiguration.Symlink = true
+	downloadConfiguration.UseCache = downloadcache.IsEnabled(c.Bool("use-cache"))
 	return
 }
 
@@ -2740,6 +4123,7 @@ func createDefaultUploadSpec(c *cli.Context) (*spec.SpecFiles, error) {
 		Explode(c.String("explode")).
 		Regexp(c.Bool("regexp")).
 		IncludeDirs(c.Bool("include-dirs")).
+		RespectIgnoreFiles(c.Bool("respect-ignore-files")).
 		Target(strings.TrimPrefix(c.Args().Get(1), "/")).
 		BuildSpec(), nil
 }
@@ -2818,6 +4202,15 @@ func createUploadConfiguration(c *cli.Context) (uploadConfiguration *utils.Uploa
 	if err != nil {
 		return
 	}
+	uploadConfiguration.SigningKeyring = c.String("sign-keyring")
+	uploadConfiguration.SigningKeyId = c.String("sign-key-id")
+	if uploadConfiguration.SigningKeyId != "" && uploadConfiguration.SigningKeyring == "" {
+		return nil, cliutils.PrintHelpAndReturnError("The --sign-key-id option can only be used with the --sign-keyring option.", c)
+	}
+	if c.String("checksum-files") != "" {
+		uploadConfiguration.ChecksumFiles = strings.Split(c.String("checksum-files"), ",")
+		err = generic.ValidateChecksumFiles(uploadConfiguration.ChecksumFiles)
+	}
 	return
 }
 
@@ -2844,6 +4237,11 @@ func validateConfigFlags(configCommandConfiguration *commands.ConfigCommandConfi
 	if !configCommandConfiguration.Interactive && configCommandConfiguration.ArtDetails.Url == "" {
 		return errors.New("The --url option is mandatory when the --interactive option is set to false")
 	}
+	if configCommandConfiguration.ArtDetails.LimitRate != "" {
+		if _, err := ratelimit.ParseRate(configCommandConfiguration.ArtDetails.LimitRate); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -2893,6 +4291,7 @@ func overrideFieldsIfSet(spec *spec.File, c *cli.Context) {
 	overrideStringIfSet(&spec.Explode, c, "explode")
 	overrideStringIfSet(&spec.Regexp, c, "regexp")
 	overrideStringIfSet(&spec.IncludeDirs, c, "include-dirs")
+	overrideStringIfSet(&spec.RespectIgnoreFiles, c, "respect-ignore-files")
 }
 
 func getOffsetAndLimitValues(c *cli.Context) (offset, limit int, err error) {
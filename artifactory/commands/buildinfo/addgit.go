@@ -11,13 +11,13 @@ import (
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
-	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 	"github.com/spf13/viper"
 	"io"
 	"os"
 	"os/exec"
 	"strconv"
+	"strings"
 )
 
 const (
@@ -25,13 +25,34 @@ const (
 	ConfigIssuesPrefix        = "issues."
 	ConfigParseValueError     = "Failed parsing %s from configuration file: %s"
 	MissingConfigurationError = "Configuration file must contain: %s"
+	IssueUrlKeyPlaceholder    = "{key}"
+	KeyCaseUpper              = "upper"
+	KeyCaseLower              = "lower"
+)
+
+// The build properties of the VCS details, which have no fields in the build info.
+// The build info of jfrog-client-go has a single VCS field, so the submodules have no VCS entries of their own. Instead, the URL and revision
+// of each submodule are properties as well, prefixed with the path of the submodule.
+// All of them are in the vcs. namespace, which is kept apart from the environment variables, but excluded and redacted like them.
+const (
+	VcsPropertiesPrefix         = "vcs."
+	VcsBranchProperty           = "vcs.branch"
+	VcsTagsProperty             = "vcs.tags"
+	VcsMessageProperty          = "vcs.message"
+	VcsAuthorProperty           = "vcs.author"
+	VcsSubmodulesPropertyPrefix = "vcs.submodules."
+	VcsSubmoduleUrlSuffix       = ".url"
+	VcsSubmoduleRevisionSuffix  = ".revision"
 )
 
 type BuildAddGitCommand struct {
 	buildConfiguration *utils.BuildConfiguration
 	dotGitPath         string
 	configFilePath     string
+	remote             string
+	envExclude         string
 	issuesConfig       *IssuesConfiguration
+	redacted           []RedactedEnv
 }
 
 func NewBuildAddGitCommand() *BuildAddGitCommand {
@@ -48,6 +69,24 @@ func (config *BuildAddGitCommand) SetConfigFilePath(configFilePath string) *Buil
 	return config
 }
 
+// Sets the git remote whose URL is added to the build.
+func (config *BuildAddGitCommand) SetRemote(remote string) *BuildAddGitCommand {
+	config.remote = remote
+	return config
+}
+
+// Sets the case insensitive patterns of the VCS properties which are not collected, in the form of "value1;value2;...".
+// The same patterns exclude the environment variables.
+func (config *BuildAddGitCommand) SetEnvExclude(envExclude string) *BuildAddGitCommand {
+	config.envExclude = envExclude
+	return config
+}
+
+// Returns the VCS properties whose values were redacted, since they look like secrets.
+func (config *BuildAddGitCommand) Redacted() []RedactedEnv {
+	return config.redacted
+}
+
 func (config *BuildAddGitCommand) SetDotGitPath(dotGitPath string) *BuildAddGitCommand {
 	config.dotGitPath = dotGitPath
 	return config
@@ -65,10 +104,10 @@ func (config *BuildAddGitCommand) Run() error {
 		return err
 	}
 
-	// Find .git folder if it wasn't provided in the command.
+	// Find .git if it wasn't provided in the command. In worktrees and submodules, .git is a file.
 	if config.dotGitPath == "" {
 		var exists bool
-		config.dotGitPath, exists, err = fileutils.FindUpstream(".git", fileutils.Dir)
+		config.dotGitPath, exists, err = git.FindWorkTree(".")
 		if err != nil {
 			return err
 		}
@@ -77,8 +116,8 @@ func (config *BuildAddGitCommand) Run() error {
 		}
 	}
 
-	// Collect URL and Revision into GitManager.
-	gitManager := git.NewManager(config.dotGitPath)
+	// Collect URL, Revision and the other details of the checked out commit into GitManager.
+	gitManager := git.NewManager(config.dotGitPath).SetRemote(config.remote)
 	err = gitManager.ReadConfig()
 	if err != nil {
 		return err
@@ -93,16 +132,29 @@ func (config *BuildAddGitCommand) Run() error {
 		}
 	}
 
+	// The VCS properties are filtered and redacted before they are saved, like the environment variables, since commit messages
+	// and URLs may include secrets.
+	vcsProperties, err := createExcludeFilter(config.envExclude)(createVcsProperties(gitManager))
+	if err != nil {
+		return err
+	}
+	vcsProperties, config.redacted = redactSecrets(vcsProperties)
+	for _, redacted := range config.redacted {
+		log.Info("Redacted the value of", redacted.Name+", which looks like a "+redacted.Reason+".")
+	}
+
 	// Populate partials with VCS info.
 	populateFunc := func(partial *buildinfo.Partial) {
 		partial.Vcs = &buildinfo.Vcs{
 			Url:      gitManager.GetUrl(),
 			Revision: gitManager.GetRevision(),
 		}
+		// The build info has no fields for the other VCS details, so they are added as build properties.
+		partial.Env = vcsProperties
 
 		if config.configFilePath != "" {
 			partial.Issues = &buildinfo.Issues{
-				Tracker:                &buildinfo.Tracker{Name: config.issuesConfig.TrackerName, Version: ""},
+				Tracker:                &buildinfo.Tracker{Name: config.issuesConfig.getTrackerNames(), Version: ""},
 				AggregateBuildIssues:   config.issuesConfig.Aggregate,
 				AggregationBuildStatus: config.issuesConfig.AggregationStatus,
 				AffectedIssues:         issues,
@@ -119,6 +171,33 @@ func (config *BuildAddGitCommand) Run() error {
 	return nil
 }
 
+// Returns the branch, tags, message and author of the checked out commit, and the URL and revision of each submodule, as build properties.
+func createVcsProperties(gitManager vcsDetails) buildinfo.Env {
+	properties := buildinfo.Env{}
+	addProperty := func(name, value string) {
+		if value != "" {
+			properties[name] = value
+		}
+	}
+	addProperty(VcsBranchProperty, gitManager.GetBranch())
+	addProperty(VcsTagsProperty, strings.Join(gitManager.GetTags(), ","))
+	addProperty(VcsMessageProperty, gitManager.GetMessage())
+	addProperty(VcsAuthorProperty, gitManager.GetAuthor())
+	for _, submodule := range gitManager.GetSubmodules() {
+		addProperty(VcsSubmodulesPropertyPrefix+submodule.Path+VcsSubmoduleUrlSuffix, submodule.Url)
+		addProperty(VcsSubmodulesPropertyPrefix+submodule.Path+VcsSubmoduleRevisionSuffix, submodule.Revision)
+	}
+	return properties
+}
+
+type vcsDetails interface {
+	GetBranch() string
+	GetTags() []string
+	GetMessage() string
+	GetAuthor() string
+	GetSubmodules() []git.Submodule
+}
+
 // Returns the ArtifactoryDetails.
 // If a config file exists, the information is taken from it. if not, the default server is returned.
 func (config *BuildAddGitCommand) RtDetails() (*utilsconfig.ArtifactoryDetails, error) {
@@ -172,34 +251,20 @@ func (config *BuildAddGitCommand) collectBuildIssues() ([]buildinfo.AffectedIssu
 }
 
 func (config *BuildAddGitCommand) DoCollect(issuesConfig *IssuesConfiguration, lastVcsRevision string) ([]buildinfo.AffectedIssue, error) {
-	// Create regex pattern.
-	issueRegexp, err := clientutils.GetRegExp(issuesConfig.Regexp)
-	if err != nil {
-		return nil, err
+	// Create a regex pattern for each tracker.
+	var patterns []*gofrogcmd.CmdOutputPattern
+	for i := range issuesConfig.Trackers {
+		pattern, err := issuesConfig.Trackers[i].createPattern()
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, pattern)
 	}
 
-	// Get log with limit, starting from the latest commit.
+	// Get log starting from the latest commit. If the previous build's revision is known, only the commits since it are included.
+	// The log limit applies in both cases, since the previous revision may not be an ancestor of the checked out commit, after a force push
+	// or a branch switch.
 	logCmd := &LogCmd{logLimit: issuesConfig.LogLimit, lastVcsRevision: lastVcsRevision}
-	var foundIssues []buildinfo.AffectedIssue
-	logRegExp := gofrogcmd.CmdOutputPattern{
-		RegExp: issueRegexp,
-		ExecFunc: func(pattern *gofrogcmd.CmdOutputPattern) (string, error) {
-			// Reached here - means no error occurred.
-
-			// Check for out of bound results.
-			if len(pattern.MatchedResults)-1 < issuesConfig.KeyGroupIndex || len(pattern.MatchedResults)-1 < issuesConfig.SummaryGroupIndex {
-				return "", errors.New("Unexpected result while parsing issues from git log. Make sure that the regular expression used to find issues, includes two capturing groups, for the issue ID and the summary.")
-			}
-			// Create found Affected Issue.
-			foundIssue := buildinfo.AffectedIssue{Key: pattern.MatchedResults[issuesConfig.KeyGroupIndex], Summary: pattern.MatchedResults[issuesConfig.SummaryGroupIndex], Aggregated: false}
-			if issuesConfig.TrackerUrl != "" {
-				foundIssue.Url = issuesConfig.TrackerUrl + pattern.MatchedResults[issuesConfig.KeyGroupIndex]
-			}
-			foundIssues = append(foundIssues, foundIssue)
-			log.Debug("Found issue: " + pattern.MatchedResults[issuesConfig.KeyGroupIndex])
-			return "", nil
-		},
-	}
 
 	// Change working dir to where .git is.
 	wd, err := os.Getwd()
@@ -213,7 +278,7 @@ func (config *BuildAddGitCommand) DoCollect(issuesConfig *IssuesConfiguration, l
 	}
 
 	// Run git command.
-	_, _, exitOk, err := gofrogcmd.RunCmdWithOutputParser(logCmd, false, &logRegExp)
+	_, _, exitOk, err := gofrogcmd.RunCmdWithOutputParser(logCmd, false, patterns...)
 	if errorutils.CheckError(err) != nil {
 		return nil, err
 	}
@@ -223,7 +288,71 @@ func (config *BuildAddGitCommand) DoCollect(issuesConfig *IssuesConfiguration, l
 	}
 
 	// Return found issues.
-	return foundIssues, nil
+	return issuesConfig.getFoundIssues(), nil
+}
+
+// Returns the issues found by the trackers, in the order of the trackers.
+// The same issue may be referenced by several commits, and found by several trackers, so it is returned once.
+func (ic *IssuesConfiguration) getFoundIssues() []buildinfo.AffectedIssue {
+	var foundIssues []buildinfo.AffectedIssue
+	foundKeys := make(map[string]bool)
+	for i := range ic.Trackers {
+		for _, issue := range ic.Trackers[i].foundIssues {
+			if !foundKeys[issue.Key] {
+				foundKeys[issue.Key] = true
+				foundIssues = append(foundIssues, issue)
+			}
+		}
+		ic.Trackers[i].foundIssues = nil
+	}
+	return foundIssues
+}
+
+// Creates the pattern which collects the issues of the tracker from the lines of git log.
+func (tracker *IssueTracker) createPattern() (*gofrogcmd.CmdOutputPattern, error) {
+	issueRegexp, err := clientutils.GetRegExp(tracker.Regexp)
+	if err != nil {
+		return nil, err
+	}
+	// Check for out of bound group indexes.
+	if issueRegexp.NumSubexp() < tracker.KeyGroupIndex || issueRegexp.NumSubexp() < tracker.SummaryGroupIndex {
+		return nil, errorutils.CheckError(errors.New("The regular expression used to find the issues of " + tracker.Name + " does not include the capturing groups of the issue key and summary: " + tracker.Regexp))
+	}
+	return &gofrogcmd.CmdOutputPattern{
+		RegExp: issueRegexp,
+		ExecFunc: func(pattern *gofrogcmd.CmdOutputPattern) (string, error) {
+			// A line may reference several issues.
+			for _, matchedResults := range pattern.RegExp.FindAllStringSubmatch(pattern.Line, -1) {
+				foundIssue := tracker.createIssue(matchedResults, pattern.Line)
+				tracker.foundIssues = append(tracker.foundIssues, foundIssue)
+				log.Debug("Found " + tracker.Name + " issue: " + foundIssue.Key)
+			}
+			// The line is returned as is, to be matched by the patterns of the other trackers.
+			return pattern.Line, nil
+		},
+	}, nil
+}
+
+// Creates an affected issue from the results of the tracker's regexp, matched in a commit subject.
+// The summary is the commit subject, unless a summary group is configured.
+func (tracker *IssueTracker) createIssue(matchedResults []string, subject string) buildinfo.AffectedIssue {
+	key := matchedResults[tracker.KeyGroupIndex]
+	switch tracker.KeyCase {
+	case KeyCaseUpper:
+		key = strings.ToUpper(key)
+	case KeyCaseLower:
+		key = strings.ToLower(key)
+	}
+	foundIssue := buildinfo.AffectedIssue{Key: tracker.KeyPrefix + key, Summary: subject, Aggregated: false}
+	if tracker.SummaryGroupIndex > 0 {
+		foundIssue.Summary = matchedResults[tracker.SummaryGroupIndex]
+	}
+	if strings.Contains(tracker.Url, IssueUrlKeyPlaceholder) {
+		foundIssue.Url = strings.Replace(tracker.Url, IssueUrlKeyPlaceholder, key, -1)
+	} else if tracker.Url != "" {
+		foundIssue.Url = tracker.Url + key
+	}
+	return foundIssue
 }
 
 func (config *BuildAddGitCommand) createIssuesConfigs() (err error) {
@@ -240,9 +369,11 @@ func (config *BuildAddGitCommand) createIssuesConfigs() (err error) {
 	}
 
 	// Add '/' suffix to URL if required.
-	if config.issuesConfig.TrackerUrl != "" {
-		// Url should end with '/'
-		config.issuesConfig.TrackerUrl = clientutils.AddTrailingSlashIfNeeded(config.issuesConfig.TrackerUrl)
+	for i, tracker := range config.issuesConfig.Trackers {
+		// Url should end with '/', unless it is a template.
+		if tracker.Url != "" && !strings.Contains(tracker.Url, IssueUrlKeyPlaceholder) {
+			config.issuesConfig.Trackers[i].Url = clientutils.AddTrailingSlashIfNeeded(tracker.Url)
+		}
 	}
 
 	return
@@ -299,42 +430,27 @@ func (ic *IssuesConfiguration) populateIssuesConfigsFromSpec(configFilePath stri
 	}
 	ic.ServerID = vConfig.GetString(ConfigIssuesPrefix + "serverID")
 
-	// Set log limit.
+	// Get log limit.
 	ic.LogLimit = GitLogLimit
-
-	// Get tracker data
-	if !vConfig.IsSet(ConfigIssuesPrefix + "trackerName") {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, ConfigIssuesPrefix+"trackerName")))
-	}
-	ic.TrackerName = vConfig.GetString(ConfigIssuesPrefix + "trackerName")
-
-	// Get issues pattern
-	if !vConfig.IsSet(ConfigIssuesPrefix + "regexp") {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, ConfigIssuesPrefix+"regexp")))
-	}
-	ic.Regexp = vConfig.GetString(ConfigIssuesPrefix + "regexp")
-
-	// Get issues base url
-	if vConfig.IsSet(ConfigIssuesPrefix + "trackerUrl") {
-		ic.TrackerUrl = vConfig.GetString(ConfigIssuesPrefix + "trackerUrl")
-	}
-
-	// Get issues key group index
-	if !vConfig.IsSet(ConfigIssuesPrefix + "keyGroupIndex") {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, ConfigIssuesPrefix+"keyGroupIndex")))
-	}
-	ic.KeyGroupIndex, err = strconv.Atoi(vConfig.GetString(ConfigIssuesPrefix + "keyGroupIndex"))
-	if err != nil {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, ConfigIssuesPrefix+"keyGroupIndex", err.Error())))
+	if vConfig.IsSet(ConfigIssuesPrefix + "logLimit") {
+		ic.LogLimit, err = strconv.Atoi(vConfig.GetString(ConfigIssuesPrefix + "logLimit"))
+		if err != nil || ic.LogLimit < 0 {
+			return errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, ConfigIssuesPrefix+"logLimit", "the limit should be a non-negative number")))
+		}
 	}
 
-	// Get issues summary group index
-	if !vConfig.IsSet(ConfigIssuesPrefix + "summaryGroupIndex") {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, ConfigIssuesPrefix+"summaryGroupIndex")))
+	// Get trackers. A single tracker may be configured directly under issues, or several trackers under issues.trackers.
+	if vConfig.IsSet(ConfigIssuesPrefix + "trackers") {
+		ic.Trackers, err = readIssueTrackers(vConfig)
+	} else {
+		var tracker *IssueTracker
+		tracker, err = readIssueTracker(vConfig.Sub("issues"), ConfigIssuesPrefix, "trackerName", "trackerUrl", true)
+		if tracker != nil {
+			ic.Trackers = []IssueTracker{*tracker}
+		}
 	}
-	ic.SummaryGroupIndex, err = strconv.Atoi(vConfig.GetString(ConfigIssuesPrefix + "summaryGroupIndex"))
 	if err != nil {
-		return errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, ConfigIssuesPrefix+"summaryGroupIndex", err.Error())))
+		return err
 	}
 
 	// Get aggregation aggregate
@@ -354,6 +470,99 @@ func (ic *IssuesConfiguration) populateIssuesConfigsFromSpec(configFilePath stri
 	return nil
 }
 
+func readIssueTrackers(vConfig *viper.Viper) ([]IssueTracker, error) {
+	trackersConfig, ok := vConfig.Get(ConfigIssuesPrefix + "trackers").([]interface{})
+	if !ok || len(trackersConfig) == 0 {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, ConfigIssuesPrefix+"trackers", "a list of trackers is expected")))
+	}
+	var trackers []IssueTracker
+	for i, trackerConfig := range trackersConfig {
+		trackerViper := viper.New()
+		for key, value := range toStringMap(trackerConfig) {
+			trackerViper.Set(key, value)
+		}
+		tracker, err := readIssueTracker(trackerViper, fmt.Sprintf("%strackers[%d].", ConfigIssuesPrefix, i), "name", "url", false)
+		if err != nil {
+			return nil, err
+		}
+		trackers = append(trackers, *tracker)
+	}
+	return trackers, nil
+}
+
+// Reads the configuration of a tracker. The prefix of its keys is used in error messages.
+// If the summary group index is not required and not set, the summary of an issue is the subject of the commit which references it.
+func readIssueTracker(vConfig *viper.Viper, prefix, nameKey, urlKey string, requireSummary bool) (*IssueTracker, error) {
+	tracker := &IssueTracker{}
+	if !vConfig.IsSet(nameKey) {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, prefix+nameKey)))
+	}
+	tracker.Name = vConfig.GetString(nameKey)
+
+	// Get issues pattern
+	if !vConfig.IsSet("regexp") {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, prefix+"regexp")))
+	}
+	tracker.Regexp = vConfig.GetString("regexp")
+
+	// Get issues base url, or url template
+	if vConfig.IsSet(urlKey) {
+		tracker.Url = vConfig.GetString(urlKey)
+	}
+
+	// Get issues key group index
+	var err error
+	if !vConfig.IsSet("keyGroupIndex") {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, prefix+"keyGroupIndex")))
+	}
+	tracker.KeyGroupIndex, err = strconv.Atoi(vConfig.GetString("keyGroupIndex"))
+	if err != nil {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, prefix+"keyGroupIndex", err.Error())))
+	}
+
+	// Get issues summary group index
+	if !vConfig.IsSet("summaryGroupIndex") && requireSummary {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(MissingConfigurationError, prefix+"summaryGroupIndex")))
+	}
+	if vConfig.IsSet("summaryGroupIndex") {
+		tracker.SummaryGroupIndex, err = strconv.Atoi(vConfig.GetString("summaryGroupIndex"))
+		if err != nil {
+			return nil, errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, prefix+"summaryGroupIndex", err.Error())))
+		}
+	}
+
+	// Get key normalisation
+	tracker.KeyPrefix = vConfig.GetString("keyPrefix")
+	tracker.KeyCase = strings.ToLower(vConfig.GetString("keyCase"))
+	if tracker.KeyCase != "" && tracker.KeyCase != KeyCaseUpper && tracker.KeyCase != KeyCaseLower {
+		return nil, errorutils.CheckError(errors.New(fmt.Sprintf(ConfigParseValueError, prefix+"keyCase", "the case should be "+KeyCaseUpper+" or "+KeyCaseLower)))
+	}
+	return tracker, nil
+}
+
+// YAML maps are decoded with interface{} keys, which viper does not accept.
+func toStringMap(value interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	switch valueMap := value.(type) {
+	case map[interface{}]interface{}:
+		for k, v := range valueMap {
+			result[fmt.Sprint(k)] = v
+		}
+	case map[string]interface{}:
+		result = valueMap
+	}
+	return result
+}
+
+// Returns the names of the trackers, separated by commas.
+func (ic *IssuesConfiguration) getTrackerNames() string {
+	var names []string
+	for _, tracker := range ic.Trackers {
+		names = append(names, tracker.Name)
+	}
+	return strings.Join(names, ",")
+}
+
 func (ic *IssuesConfiguration) setArtifactoryDetails() error {
 	artDetails, err := utilsconfig.GetArtifactoryConf(ic.ServerID)
 	if err != nil {
@@ -364,18 +573,29 @@ func (ic *IssuesConfiguration) setArtifactoryDetails() error {
 }
 
 type IssuesConfiguration struct {
-	ArtDetails        *utilsconfig.ArtifactoryDetails
-	Regexp            string
+	ArtDetails *utilsconfig.ArtifactoryDetails
+	// The maximal number of commits read, whether or not the previous build's revision is known. 0 means no limit.
 	LogLimit          int
-	TrackerUrl        string
-	TrackerName       string
-	KeyGroupIndex     int
-	SummaryGroupIndex int
+	Trackers          []IssueTracker
 	Aggregate         bool
 	AggregationStatus string
 	ServerID          string
 }
 
+// An issue tracker, such as JIRA or GitHub issues, whose issues are referenced by the commit messages.
+type IssueTracker struct {
+	Name   string
+	Regexp string
+	// The base URL of the issues, to which the key is appended, or a template which includes IssueUrlKeyPlaceholder.
+	Url               string
+	KeyGroupIndex     int
+	SummaryGroupIndex int
+	// The key is normalised by changing its case to KeyCase if set, and adding KeyPrefix to it, for example "#" to GitHub issue numbers.
+	KeyCase     string
+	KeyPrefix   string
+	foundIssues []buildinfo.AffectedIssue
+}
+
 type LogCmd struct {
 	logLimit        int
 	lastVcsRevision string
@@ -384,7 +604,10 @@ type LogCmd struct {
 func (logCmd *LogCmd) GetCmd() *exec.Cmd {
 	var cmd []string
 	cmd = append(cmd, "git")
-	cmd = append(cmd, "log", "--pretty=format:%s", "-"+strconv.Itoa(logCmd.logLimit))
+	cmd = append(cmd, "log", "--pretty=format:%s")
+	if logCmd.logLimit > 0 {
+		cmd = append(cmd, "-"+strconv.Itoa(logCmd.logLimit))
+	}
 	if logCmd.lastVcsRevision != "" {
 		cmd = append(cmd, logCmd.lastVcsRevision+"..")
 	}
@@ -3,12 +3,14 @@ package buildinfo
 import (
 	"fmt"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils/git"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
 	"github.com/jfrog/jfrog-cli-go/utils/log"
 	"github.com/jfrog/jfrog-cli-go/utils/tests"
 	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 )
@@ -95,12 +97,14 @@ func checkVCSUrl(partials buildinfo.Partials, t *testing.T) {
 func TestPopulateIssuesConfigurations(t *testing.T) {
 	// Test success scenario
 	expectedIssuesConfiguration := &IssuesConfiguration{
-		ServerID:          "local",
-		TrackerName:       "TESTING",
-		TrackerUrl:        "http://TESTING.com",
-		Regexp:            `([a-zA-Z]+-[0-9]*)\s-\s(.*)`,
-		KeyGroupIndex:     1,
-		SummaryGroupIndex: 2,
+		ServerID: "local",
+		Trackers: []IssueTracker{{
+			Name:              "TESTING",
+			Url:               "http://TESTING.com",
+			Regexp:            `([a-zA-Z]+-[0-9]*)\s-\s(.*)`,
+			KeyGroupIndex:     1,
+			SummaryGroupIndex: 2,
+		}},
 		Aggregate:         true,
 		AggregationStatus: "RELEASE",
 		LogLimit:          100,
@@ -113,7 +117,7 @@ func TestPopulateIssuesConfigurations(t *testing.T) {
 		t.Error(fmt.Sprintf("Reading configurations file ended with error: %s", err.Error()))
 		t.FailNow()
 	}
-	if *ic != *expectedIssuesConfiguration {
+	if !reflect.DeepEqual(ic, expectedIssuesConfiguration) {
 		t.Error(fmt.Sprintf("Failed reading configurations file. Expected: %+v Received: %+v", *expectedIssuesConfiguration, *ic))
 		t.FailNow()
 	}
@@ -135,6 +139,97 @@ func TestPopulateIssuesConfigurations(t *testing.T) {
 	}
 }
 
+func TestPopulateIssueTrackers(t *testing.T) {
+	expectedIssuesConfiguration := &IssuesConfiguration{
+		ServerID: "local",
+		Trackers: []IssueTracker{{
+			Name:          "JIRA",
+			Url:           "https://jira.example.com/browse/{key}",
+			Regexp:        `\b([a-zA-Z]+-[0-9]+)\b`,
+			KeyGroupIndex: 1,
+			KeyCase:       KeyCaseUpper,
+		}, {
+			Name:              "GitHub",
+			Url:               "https://github.com/example/project/issues",
+			Regexp:            `#([0-9]+):?\s*(.*)`,
+			KeyGroupIndex:     1,
+			SummaryGroupIndex: 2,
+			KeyPrefix:         "#",
+		}},
+		LogLimit: 0,
+	}
+	ic := new(IssuesConfiguration)
+	err := ic.populateIssuesConfigsFromSpec(filepath.Join("..", "testdata", "buildissues", "issuesconfig_trackers.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(ic, expectedIssuesConfiguration) {
+		t.Errorf("Failed reading configurations file. Expected: %+v Received: %+v", *expectedIssuesConfiguration, *ic)
+	}
+}
+
+func TestCollectIssuesOfTrackers(t *testing.T) {
+	ic := &IssuesConfiguration{Trackers: []IssueTracker{{
+		Name:          "JIRA",
+		Url:           "https://jira.example.com/browse/{key}",
+		Regexp:        `\b([a-zA-Z]+-[0-9]+)\b`,
+		KeyGroupIndex: 1,
+		KeyCase:       KeyCaseUpper,
+	}, {
+		Name:          "GitHub",
+		Url:           "https://github.com/example/project/issues/",
+		Regexp:        `#([0-9]+)`,
+		KeyGroupIndex: 1,
+		KeyPrefix:     "#",
+	}}}
+	lines := []string{"proj-1 Fix the build (#12)", "PROJ-2 and PROJ-1 Add tests", "Revert #12"}
+	for i := range ic.Trackers {
+		pattern, err := ic.Trackers[i].createPattern()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, line := range lines {
+			if pattern.RegExp.MatchString(line) {
+				pattern.Line = line
+				if _, err = pattern.ExecFunc(pattern); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+	}
+	expected := []buildinfo.AffectedIssue{
+		{Key: "PROJ-1", Url: "https://jira.example.com/browse/PROJ-1", Summary: "proj-1 Fix the build (#12)"},
+		{Key: "PROJ-2", Url: "https://jira.example.com/browse/PROJ-2", Summary: "PROJ-2 and PROJ-1 Add tests"},
+		{Key: "#12", Url: "https://github.com/example/project/issues/12", Summary: "proj-1 Fix the build (#12)"},
+	}
+	if issues := ic.getFoundIssues(); !reflect.DeepEqual(issues, expected) {
+		t.Errorf("Expected issues %v, got %v", expected, issues)
+	}
+
+	// A summary group which does not exist in the regexp.
+	tracker := IssueTracker{Name: "invalid", Regexp: `#([0-9]+)`, KeyGroupIndex: 1, SummaryGroupIndex: 2}
+	if _, err := tracker.createPattern(); err == nil {
+		t.Error("Expected an error for a missing summary group.")
+	}
+}
+
+func TestLogCmdGetCmd(t *testing.T) {
+	tests := []struct {
+		logCmd   LogCmd
+		expected []string
+	}{
+		{LogCmd{logLimit: 100}, []string{"git", "log", "--pretty=format:%s", "-100"}},
+		{LogCmd{logLimit: 100, lastVcsRevision: "abc"}, []string{"git", "log", "--pretty=format:%s", "-100", "abc.."}},
+		{LogCmd{lastVcsRevision: "abc"}, []string{"git", "log", "--pretty=format:%s", "abc.."}},
+		{LogCmd{}, []string{"git", "log", "--pretty=format:%s"}},
+	}
+	for _, test := range tests {
+		if actual := test.logCmd.GetCmd().Args; !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected the command %v, got %v.", test.expected, actual)
+		}
+	}
+}
+
 func TestAddGitDoCollect(t *testing.T) {
 	// Create git folder with files
 	originalFolder := "git_issues_.git_suffix"
@@ -143,12 +238,14 @@ func TestAddGitDoCollect(t *testing.T) {
 	// Create BuildAddGitCommand
 	config := BuildAddGitCommand{
 		issuesConfig: &IssuesConfiguration{
-			LogLimit:          100,
-			Aggregate:         false,
-			SummaryGroupIndex: 2,
-			KeyGroupIndex:     1,
-			Regexp:            `(.+-[0-9]+)\s-\s(.+)`,
-			TrackerName:       "test",
+			LogLimit:  100,
+			Aggregate: false,
+			Trackers: []IssueTracker{{
+				SummaryGroupIndex: 2,
+				KeyGroupIndex:     1,
+				Regexp:            `(.+-[0-9]+)\s-\s(.+)`,
+				Name:              "test",
+			}},
 		},
 		buildConfiguration: &utils.BuildConfiguration{BuildNumber: "1", BuildName: "cli-test-build-issues"},
 		configFilePath:     "",
@@ -256,3 +353,26 @@ func TestRtDetailsWithoutConfigFile(t *testing.T) {
 		t.Error(fmt.Sprintf("Expected %s, got %s", details.User, expectedUser))
 	}
 }
+
+type testVcsDetails struct{}
+
+func (testVcsDetails) GetBranch() string  { return "master" }
+func (testVcsDetails) GetTags() []string  { return []string{"v1", "v1.0.0"} }
+func (testVcsDetails) GetMessage() string { return "Fix the build" }
+func (testVcsDetails) GetAuthor() string  { return "" }
+func (testVcsDetails) GetSubmodules() []git.Submodule {
+	return []git.Submodule{{Path: "lib/library", Url: "https://example.com/library.git", Revision: "abc"}}
+}
+
+func TestCreateVcsProperties(t *testing.T) {
+	expected := buildinfo.Env{
+		VcsBranchProperty:                     "master",
+		VcsTagsProperty:                       "v1,v1.0.0",
+		VcsMessageProperty:                    "Fix the build",
+		"vcs.submodules.lib/library.url":      "https://example.com/library.git",
+		"vcs.submodules.lib/library.revision": "abc",
+	}
+	if properties := createVcsProperties(testVcsDetails{}); !reflect.DeepEqual(properties, expected) {
+		t.Error("Expected", expected, "got", properties)
+	}
+}
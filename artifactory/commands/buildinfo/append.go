@@ -0,0 +1,113 @@
+package buildinfo
+
+import (
+	"errors"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The ways of adding a published build to the build info.
+const (
+	AppendAsModule     = "module"
+	AppendAsDependency = "dependency"
+)
+
+// Adds the artifacts of a published build to the build info collected locally, either as the artifacts of a module, or as dependencies.
+type BuildAppendCommand struct {
+	rtDetails          *config.ArtifactoryDetails
+	buildConfiguration *utils.BuildConfiguration
+	appendedBuild      *utils.BuildConfiguration
+	appendAs           string
+	moduleId           string
+}
+
+func NewBuildAppendCommand() *BuildAppendCommand {
+	return &BuildAppendCommand{appendAs: AppendAsModule}
+}
+
+func (bac *BuildAppendCommand) SetRtDetails(rtDetails *config.ArtifactoryDetails) *BuildAppendCommand {
+	bac.rtDetails = rtDetails
+	return bac
+}
+
+func (bac *BuildAppendCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *BuildAppendCommand {
+	bac.buildConfiguration = buildConfiguration
+	return bac
+}
+
+func (bac *BuildAppendCommand) SetAppendedBuild(appendedBuild *utils.BuildConfiguration) *BuildAppendCommand {
+	bac.appendedBuild = appendedBuild
+	return bac
+}
+
+func (bac *BuildAppendCommand) SetAppendAs(appendAs string) *BuildAppendCommand {
+	bac.appendAs = appendAs
+	return bac
+}
+
+// Sets the ID of the module the appended build is added to.
+// By default, a module is named after the appended build, and dependencies are added to the module named after the build.
+func (bac *BuildAppendCommand) SetModuleId(moduleId string) *BuildAppendCommand {
+	bac.moduleId = moduleId
+	return bac
+}
+
+func (bac *BuildAppendCommand) CommandName() string {
+	return "rt_build_append"
+}
+
+func (bac *BuildAppendCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return bac.rtDetails, nil
+}
+
+func (bac *BuildAppendCommand) Run() error {
+	if bac.appendAs != AppendAsModule && bac.appendAs != AppendAsDependency {
+		return errorutils.CheckError(errors.New("A build can be appended as a " + AppendAsModule + " or as a " + AppendAsDependency + ", not as a " + bac.appendAs + "."))
+	}
+	servicesManager, err := utils.CreateServiceManager(bac.rtDetails, false)
+	if err != nil {
+		return err
+	}
+	appendedBuild, err := getPublishedBuildInfo(bac.appendedBuild, servicesManager)
+	if err != nil {
+		return err
+	}
+	buildName, buildNumber := bac.buildConfiguration.BuildName, bac.buildConfiguration.BuildNumber
+	if err = utils.SaveBuildGeneralDetails(buildName, buildNumber); err != nil {
+		return err
+	}
+	populateFunc := func(partial *buildinfo.Partial) {
+		populateAppendedBuildPartial(partial, appendedBuild, bac.appendAs, bac.moduleId)
+	}
+	if err = utils.SavePartialBuildInfo(buildName, buildNumber, populateFunc); err != nil {
+		return err
+	}
+	log.Info("Appended build", appendedBuild.Name+"/"+appendedBuild.Number, "as a", bac.appendAs, "of build", buildName+"/"+buildNumber+".")
+	return nil
+}
+
+// Adds the artifacts of all the modules of the appended build to the partial build info.
+func populateAppendedBuildPartial(partial *buildinfo.Partial, appendedBuild *buildinfo.BuildInfo, appendAs, moduleId string) {
+	partial.ModuleId = moduleId
+	if appendAs == AppendAsDependency {
+		// Modules without an ID are named after the build, when the build info is published.
+		partial.Dependencies = []buildinfo.Dependency{}
+		for _, module := range appendedBuild.Modules {
+			for _, artifact := range module.Artifacts {
+				partial.Dependencies = append(partial.Dependencies, buildinfo.Dependency{Id: artifact.Name, Checksum: artifact.Checksum})
+			}
+		}
+		return
+	}
+	if partial.ModuleId == "" {
+		partial.ModuleId = appendedBuild.Name + "/" + appendedBuild.Number
+	}
+	partial.Artifacts = []buildinfo.Artifact{}
+	for _, module := range appendedBuild.Modules {
+		partial.Artifacts = append(partial.Artifacts, module.Artifacts...)
+	}
+}
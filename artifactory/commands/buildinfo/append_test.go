@@ -0,0 +1,33 @@
+package buildinfo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestPopulateAppendedBuildPartial(t *testing.T) {
+	appendedBuild := &buildinfo.BuildInfo{
+		Name:   "component",
+		Number: "3",
+		Modules: []buildinfo.Module{
+			{Id: "a", Artifacts: []buildinfo.Artifact{{Name: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}}},
+			{Id: "b", Artifacts: []buildinfo.Artifact{{Name: "b.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}}},
+		},
+	}
+
+	partial := &buildinfo.Partial{}
+	populateAppendedBuildPartial(partial, appendedBuild, AppendAsModule, "")
+	expectedArtifacts := []buildinfo.Artifact{{Name: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}, {Name: "b.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}}
+	if partial.ModuleId != "component/3" || !reflect.DeepEqual(partial.Artifacts, expectedArtifacts) || partial.Dependencies != nil {
+		t.Errorf("Unexpected partial build info of an appended module: %+v", partial)
+	}
+
+	partial = &buildinfo.Partial{}
+	populateAppendedBuildPartial(partial, appendedBuild, AppendAsDependency, "release")
+	expectedDependencies := []buildinfo.Dependency{{Id: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}, {Id: "b.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}}
+	if partial.ModuleId != "release" || !reflect.DeepEqual(partial.Dependencies, expectedDependencies) || partial.Artifacts != nil {
+		t.Errorf("Unexpected partial build info of appended dependencies: %+v", partial)
+	}
+}
@@ -1,6 +1,12 @@
 package buildinfo
 
 import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
 	"github.com/jfrog/jfrog-client-go/utils/log"
@@ -8,6 +14,11 @@ import (
 
 type BuildCleanCommand struct {
 	buildConfiguration *utils.BuildConfiguration
+	// If true, the data of all the builds and the other data kept in the temp dir is removed, instead of the data of one build.
+	all bool
+	// If set, only the data of all the builds and the other data kept in the temp dir, which is older than this age, is removed.
+	olderThan time.Duration
+	removed   []utils.TempData
 }
 
 func NewBuildCleanCommand() *BuildCleanCommand {
@@ -19,6 +30,21 @@ func (bcc *BuildCleanCommand) SetBuildConfiguration(buildConfiguration *utils.Bu
 	return bcc
 }
 
+func (bcc *BuildCleanCommand) SetAll(all bool) *BuildCleanCommand {
+	bcc.all = all
+	return bcc
+}
+
+func (bcc *BuildCleanCommand) SetOlderThan(olderThan time.Duration) *BuildCleanCommand {
+	bcc.olderThan = olderThan
+	return bcc
+}
+
+// Returns the removed temp data, when cleaning all the builds.
+func (bcc *BuildCleanCommand) Removed() []utils.TempData {
+	return bcc.removed
+}
+
 func (bcc *BuildCleanCommand) CommandName() string {
 	return "rt_build_clean"
 }
@@ -29,6 +55,12 @@ func (bcc *BuildCleanCommand) RtDetails() (*config.ArtifactoryDetails, error) {
 }
 
 func (bcc *BuildCleanCommand) Run() error {
+	if bcc.all || bcc.olderThan > 0 {
+		log.Info("Cleaning the data of all builds...")
+		var err error
+		bcc.removed, err = utils.RemoveTempData(time.Now().Add(-bcc.olderThan))
+		return err
+	}
 	log.Info("Cleaning build info...")
 	err := utils.RemoveBuildDir(bcc.buildConfiguration.BuildName, bcc.buildConfiguration.BuildNumber)
 	if err != nil {
@@ -37,3 +69,15 @@ func (bcc *BuildCleanCommand) Run() error {
 	log.Info("Cleaned build info", bcc.buildConfiguration.BuildName+"/"+bcc.buildConfiguration.BuildNumber+".")
 	return nil
 }
+
+// Shows the removed temp data as a table of its type, name, age and path.
+func CreateRemovedTempDataTable(removed []utils.TempData, now time.Time) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "REMOVED\tNAME\tAGE\tPATH")
+	for _, data := range removed {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", data.Type, data.Name, utils.FormatAge(now.Sub(data.Created)), data.Path)
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
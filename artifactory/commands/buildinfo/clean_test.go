@@ -0,0 +1,22 @@
+package buildinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+)
+
+func TestCreateRemovedTempDataTable(t *testing.T) {
+	now := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	removed := []utils.TempData{
+		{Type: "build-info", Name: "build/1", Created: now.Add(-50 * time.Hour), Path: "/tmp/jfrog/builds/a"},
+		{Type: "file", Name: "data", Created: now.Add(-5 * time.Minute), Path: "/tmp/jfrog/data"},
+	}
+	expected := "REMOVED     NAME     AGE  PATH\n" +
+		"build-info  build/1  2d   /tmp/jfrog/builds/a\n" +
+		"file        data     5m   /tmp/jfrog/data"
+	if actual := CreateRemovedTempDataTable(removed, now); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"strings"
 )
 
+const buildInfoEnvPrefix = "buildInfo.env."
+
 type BuildCollectEnvCommand struct {
 	buildConfiguration *utils.BuildConfiguration
+	config             *buildinfo.Configuration
+	redacted           []RedactedEnv
 }
 
 func NewBuildCollectEnvCommand() *BuildCollectEnvCommand {
@@ -22,14 +26,34 @@ func (bcec *BuildCollectEnvCommand) SetBuildConfiguration(buildConfiguration *ut
 	return bcec
 }
 
+// Sets the include and exclude patterns of the environment variables, which are applied before they are saved.
+func (bcec *BuildCollectEnvCommand) SetConfig(config *buildinfo.Configuration) *BuildCollectEnvCommand {
+	bcec.config = config
+	return bcec
+}
+
+// Returns the environment variables whose values were redacted, since they look like secrets.
+func (bcec *BuildCollectEnvCommand) Redacted() []RedactedEnv {
+	return bcec.redacted
+}
+
 func (bcec *BuildCollectEnvCommand) Run() error {
 	log.Info("Collecting environment variables...")
 	err := utils.SaveBuildGeneralDetails(bcec.buildConfiguration.BuildName, bcec.buildConfiguration.BuildNumber)
 	if err != nil {
 		return err
 	}
+	// The variables are filtered and redacted before they are saved, so that secrets are not kept in the temp dir until the build is published.
+	env, err := bcec.filterEnvVariables(getEnvVariables())
+	if err != nil {
+		return err
+	}
+	env, bcec.redacted = redactSecrets(env)
+	for _, redacted := range bcec.redacted {
+		log.Info("Redacted the value of", strings.TrimPrefix(redacted.Name, buildInfoEnvPrefix)+", which looks like a "+redacted.Reason+".")
+	}
 	populateFunc := func(partial *buildinfo.Partial) {
-		partial.Env = getEnvVariables()
+		partial.Env = env
 	}
 	err = utils.SavePartialBuildInfo(bcec.buildConfiguration.BuildName, bcec.buildConfiguration.BuildNumber, populateFunc)
 	if err != nil {
@@ -39,6 +63,18 @@ func (bcec *BuildCollectEnvCommand) Run() error {
 	return nil
 }
 
+// Applies the same include and exclude patterns as build-publish.
+func (bcec *BuildCollectEnvCommand) filterEnvVariables(env buildinfo.Env) (buildinfo.Env, error) {
+	if bcec.config == nil {
+		return env, nil
+	}
+	env, err := createIncludeFilter(bcec.config.EnvInclude)(env)
+	if err != nil {
+		return nil, err
+	}
+	return createExcludeFilter(bcec.config.EnvExclude)(env)
+}
+
 // Returns the default configured Artifactory server
 func (bcec *BuildCollectEnvCommand) RtDetails() (*config.ArtifactoryDetails, error) {
 	return config.GetDefaultArtifactoryConf()
@@ -51,9 +87,10 @@ func (bcec *BuildCollectEnvCommand) CommandName() string {
 func getEnvVariables() buildinfo.Env {
 	m := make(map[string]string)
 	for _, e := range os.Environ() {
-		pair := strings.Split(e, "=")
-		if len(pair[0]) != 0 {
-			m["buildInfo.env."+pair[0]] = pair[1]
+		// The value may include '='.
+		pair := strings.SplitN(e, "=", 2)
+		if len(pair[0]) != 0 && len(pair) == 2 {
+			m[buildInfoEnvPrefix+pair[0]] = pair[1]
 		}
 	}
 	return m
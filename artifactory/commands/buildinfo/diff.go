@@ -0,0 +1,407 @@
+package buildinfo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// The differences between two builds.
+type BuildDiff struct {
+	From         string      `json:"from,omitempty"`
+	To           string      `json:"to,omitempty"`
+	Artifacts    []FileDiff  `json:"artifacts"`
+	Dependencies []FileDiff  `json:"dependencies"`
+	Vcs          *VcsDiff    `json:"vcs,omitempty"`
+	Env          []EnvDiff   `json:"env"`
+	NewIssues    []IssueDiff `json:"newIssues"`
+}
+
+// An artifact or dependency which was added, removed or changed in a module.
+// FromModule and FromName are set if a changed file had a different module or name in the first build, such as a new version in its ID.
+type FileDiff struct {
+	Module     string `json:"module,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Status     string `json:"status,omitempty"`
+	FromModule string `json:"fromModule,omitempty"`
+	FromName   string `json:"fromName,omitempty"`
+	FromSha1   string `json:"fromSha1,omitempty"`
+	ToSha1     string `json:"toSha1,omitempty"`
+}
+
+type VcsDiff struct {
+	FromUrl      string `json:"fromUrl,omitempty"`
+	ToUrl        string `json:"toUrl,omitempty"`
+	FromRevision string `json:"fromRevision,omitempty"`
+	ToRevision   string `json:"toRevision,omitempty"`
+}
+
+type EnvDiff struct {
+	Name      string `json:"name,omitempty"`
+	Status    string `json:"status,omitempty"`
+	FromValue string `json:"fromValue,omitempty"`
+	ToValue   string `json:"toValue,omitempty"`
+}
+
+type IssueDiff struct {
+	Key     string `json:"key,omitempty"`
+	Url     string `json:"url,omitempty"`
+	Summary string `json:"summary,omitempty"`
+}
+
+func (bd *BuildDiff) IsEmpty() bool {
+	return len(bd.Artifacts) == 0 && len(bd.Dependencies) == 0 && bd.Vcs == nil && len(bd.Env) == 0 && len(bd.NewIssues) == 0
+}
+
+// Compares two builds. Each of them is either a build published to Artifactory, or a build which was not published yet.
+type BuildDiffCommand struct {
+	rtDetails *config.ArtifactoryDetails
+	from      *utils.BuildConfiguration
+	to        *utils.BuildConfiguration
+	// If true, the build-info of the second build is created from the data collected locally.
+	toLocal bool
+	config  *buildinfo.Configuration
+	diff    *BuildDiff
+}
+
+func NewBuildDiffCommand() *BuildDiffCommand {
+	return &BuildDiffCommand{}
+}
+
+func (bdc *BuildDiffCommand) SetRtDetails(rtDetails *config.ArtifactoryDetails) *BuildDiffCommand {
+	bdc.rtDetails = rtDetails
+	return bdc
+}
+
+func (bdc *BuildDiffCommand) SetBuilds(from, to *utils.BuildConfiguration) *BuildDiffCommand {
+	bdc.from = from
+	bdc.to = to
+	return bdc
+}
+
+func (bdc *BuildDiffCommand) SetToLocal(toLocal bool) *BuildDiffCommand {
+	bdc.toLocal = toLocal
+	return bdc
+}
+
+// The configuration used for creating the build-info of a local build.
+func (bdc *BuildDiffCommand) SetConfig(config *buildinfo.Configuration) *BuildDiffCommand {
+	bdc.config = config
+	return bdc
+}
+
+func (bdc *BuildDiffCommand) Diff() *BuildDiff {
+	return bdc.diff
+}
+
+func (bdc *BuildDiffCommand) CommandName() string {
+	return "rt_build_diff"
+}
+
+func (bdc *BuildDiffCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return bdc.rtDetails, nil
+}
+
+func (bdc *BuildDiffCommand) Run() error {
+	servicesManager, err := utils.CreateServiceManager(bdc.rtDetails, false)
+	if err != nil {
+		return err
+	}
+	from, err := getPublishedBuildInfo(bdc.from, servicesManager)
+	if err != nil {
+		return err
+	}
+	var to *buildinfo.BuildInfo
+	if bdc.toLocal {
+		to, err = createLocalBuildInfo(bdc.to, bdc.config)
+	} else {
+		to, err = getPublishedBuildInfo(bdc.to, servicesManager)
+	}
+	if err != nil {
+		return err
+	}
+	bdc.diff = diffBuildInfo(from, to)
+	log.Info("Compared build", from.Name+"/"+from.Number, "with build", to.Name+"/"+to.Number+".")
+	return nil
+}
+
+func getPublishedBuildInfo(buildConfiguration *utils.BuildConfiguration, servicesManager *artifactory.ArtifactoryServicesManager) (*buildinfo.BuildInfo, error) {
+	buildInfoParams := services.NewBuildInfoParams()
+	buildInfoParams.BuildName = buildConfiguration.BuildName
+	buildInfoParams.BuildNumber = buildConfiguration.BuildNumber
+	build, err := servicesManager.GetBuildInfo(buildInfoParams)
+	if err != nil {
+		return nil, err
+	}
+	if build.Name == "" {
+		return nil, errorutils.CheckError(errors.New("Build " + buildConfiguration.BuildName + "/" + buildConfiguration.BuildNumber + " was not found in Artifactory."))
+	}
+	return build, nil
+}
+
+func diffBuildInfo(from, to *buildinfo.BuildInfo) *BuildDiff {
+	diff := &BuildDiff{
+		From:         from.Name + "/" + from.Number,
+		To:           to.Name + "/" + to.Number,
+		Artifacts:    diffFiles(getArtifactChecksums(from), getArtifactChecksums(to)),
+		Dependencies: diffFiles(getDependencyChecksums(from), getDependencyChecksums(to)),
+		Env:          diffEnv(from.Properties, to.Properties),
+		NewIssues:    getNewIssues(from.Issues, to.Issues),
+	}
+	fromVcs, toVcs := getVcs(from), getVcs(to)
+	if fromVcs != toVcs {
+		diff.Vcs = &VcsDiff{FromUrl: fromVcs.Url, ToUrl: toVcs.Url, FromRevision: fromVcs.Revision, ToRevision: toVcs.Revision}
+	}
+	return diff
+}
+
+// An artifact or dependency of a module.
+type moduleFile struct {
+	module string
+	name   string
+	sha1   string
+}
+
+func getArtifactChecksums(build *buildinfo.BuildInfo) []moduleFile {
+	var files []moduleFile
+	for _, module := range build.Modules {
+		for _, artifact := range module.Artifacts {
+			files = append(files, moduleFile{module.Id, artifact.Name, getSha1(artifact.Checksum)})
+		}
+	}
+	return files
+}
+
+func getDependencyChecksums(build *buildinfo.BuildInfo) []moduleFile {
+	var files []moduleFile
+	for _, module := range build.Modules {
+		for _, dependency := range module.Dependencies {
+			files = append(files, moduleFile{module.Id, dependency.Id, getSha1(dependency.Checksum)})
+		}
+	}
+	return files
+}
+
+func getSha1(checksum *buildinfo.Checksum) string {
+	if checksum == nil {
+		return ""
+	}
+	return checksum.Sha1
+}
+
+// A version, which is the last part of IDs such as group:artifact:version and module:v1.2.3.
+var idVersionRegExp = regexp.MustCompile(`:v?\d[\w.+-]*$`)
+
+// Removes the version from a module or dependency ID, so that the IDs of different versions of the same module or dependency are the same.
+func removeIdVersion(id string) string {
+	return idVersionRegExp.ReplaceAllString(id, "")
+}
+
+// Returns the module ID and the name of a file without their versions.
+// The version of the module is also removed from the names of its artifacts, such as app-1.0.jar of the org:app:1.0 module.
+func removeFileVersion(file moduleFile) (string, string) {
+	name := removeIdVersion(file.name)
+	if moduleVersion := strings.TrimPrefix(idVersionRegExp.FindString(file.module), ":"); moduleVersion != "" {
+		name = strings.Replace(name, moduleVersion, "", 1)
+	}
+	return removeIdVersion(file.module), name
+}
+
+// Files are paired in stages, each by a less strict key than the previous one:
+// the module ID and the name, the module ID and the name without their versions, and the checksum.
+// Since the version is part of the module ID of Maven and Go modules, and of the ID of dependencies,
+// a new version of a module or a dependency would otherwise be reported as removed and added.
+var fileKeys = []func(file moduleFile) string{
+	func(file moduleFile) string {
+		return file.module + "\n" + file.name
+	},
+	func(file moduleFile) string {
+		module, name := removeFileVersion(file)
+		return module + "\n" + name
+	},
+	func(file moduleFile) string {
+		return file.sha1
+	},
+}
+
+// Returns the added, removed and changed files, sorted by module and name.
+// A file which was paired with a file of a different module or name is reported with the module and name of the second build.
+// A file whose checksum is the same in both builds is not reported, even if its module or name changed.
+func diffFiles(from, to []moduleFile) []FileDiff {
+	diff := []FileDiff{}
+	fromPaired, toPaired := make([]bool, len(from)), make([]bool, len(to))
+	for _, key := range fileKeys {
+		unpairedFrom := make(map[string][]int)
+		for i, file := range from {
+			if k := key(file); !fromPaired[i] && k != "" {
+				unpairedFrom[k] = append(unpairedFrom[k], i)
+			}
+		}
+		for j, toFile := range to {
+			candidates := unpairedFrom[key(toFile)]
+			if toPaired[j] || len(candidates) == 0 {
+				continue
+			}
+			i := candidates[0]
+			unpairedFrom[key(toFile)] = candidates[1:]
+			fromPaired[i], toPaired[j] = true, true
+			fromFile := from[i]
+			if fromFile.sha1 == toFile.sha1 {
+				continue
+			}
+			fileDiff := FileDiff{Module: toFile.module, Name: toFile.name, Status: DiffChanged, FromSha1: fromFile.sha1, ToSha1: toFile.sha1}
+			if fromFile.module != toFile.module {
+				fileDiff.FromModule = fromFile.module
+			}
+			if fromFile.name != toFile.name {
+				fileDiff.FromName = fromFile.name
+			}
+			diff = append(diff, fileDiff)
+		}
+	}
+	for i, file := range from {
+		if !fromPaired[i] {
+			diff = append(diff, FileDiff{Module: file.module, Name: file.name, Status: DiffRemoved, FromSha1: file.sha1})
+		}
+	}
+	for j, file := range to {
+		if !toPaired[j] {
+			diff = append(diff, FileDiff{Module: file.module, Name: file.name, Status: DiffAdded, ToSha1: file.sha1})
+		}
+	}
+	sort.SliceStable(diff, func(i, j int) bool {
+		if diff[i].Module != diff[j].Module {
+			return diff[i].Module < diff[j].Module
+		}
+		return diff[i].Name < diff[j].Name
+	})
+	return diff
+}
+
+// Returns the added, removed and changed environment properties, sorted by name.
+// The VCS properties added by build-add-git, such as the submodules, are not environment properties, so they are not compared.
+func diffEnv(from, to buildinfo.Env) []EnvDiff {
+	diff := []EnvDiff{}
+	for name, fromValue := range from {
+		if strings.HasPrefix(name, VcsPropertiesPrefix) {
+			continue
+		}
+		toValue, exists := to[name]
+		if !exists {
+			diff = append(diff, EnvDiff{Name: name, Status: DiffRemoved, FromValue: fromValue})
+		} else if toValue != fromValue {
+			diff = append(diff, EnvDiff{Name: name, Status: DiffChanged, FromValue: fromValue, ToValue: toValue})
+		}
+	}
+	for name, toValue := range to {
+		if _, exists := from[name]; !exists && !strings.HasPrefix(name, VcsPropertiesPrefix) {
+			diff = append(diff, EnvDiff{Name: name, Status: DiffAdded, ToValue: toValue})
+		}
+	}
+	sort.Slice(diff, func(i, j int) bool {
+		return diff[i].Name < diff[j].Name
+	})
+	return diff
+}
+
+// Returns the issues of the second build which are not issues of the first build, sorted by key.
+func getNewIssues(from, to *buildinfo.Issues) []IssueDiff {
+	newIssues := []IssueDiff{}
+	if to == nil {
+		return newIssues
+	}
+	fromKeys := make(map[string]bool)
+	if from != nil {
+		for _, issue := range from.AffectedIssues {
+			fromKeys[issue.Key] = true
+		}
+	}
+	for _, issue := range to.AffectedIssues {
+		if !fromKeys[issue.Key] {
+			newIssues = append(newIssues, IssueDiff{Key: issue.Key, Url: issue.Url, Summary: issue.Summary})
+		}
+	}
+	sort.Slice(newIssues, func(i, j int) bool {
+		return newIssues[i].Key < newIssues[j].Key
+	})
+	return newIssues
+}
+
+func getVcs(build *buildinfo.BuildInfo) buildinfo.Vcs {
+	if build.Vcs == nil {
+		return buildinfo.Vcs{}
+	}
+	return *build.Vcs
+}
+
+// Shows the build diff as a summary line, followed by a table for each of its non empty sections.
+func CreateBuildDiffOutput(diff *BuildDiff) string {
+	if diff.IsEmpty() {
+		return "No differences were found between build " + diff.From + " and build " + diff.To + "."
+	}
+	buffer := new(bytes.Buffer)
+	buffer.WriteString("Comparing build " + diff.From + " with build " + diff.To + ".\n")
+	if diff.Vcs != nil {
+		buffer.WriteString("\nVCS:\n")
+		writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+		if diff.Vcs.FromUrl != diff.Vcs.ToUrl {
+			fmt.Fprintf(writer, "  URL:\t%s -> %s\n", diff.Vcs.FromUrl, diff.Vcs.ToUrl)
+		}
+		if diff.Vcs.FromRevision != diff.Vcs.ToRevision {
+			fmt.Fprintf(writer, "  Revision:\t%s -> %s\n", diff.Vcs.FromRevision, diff.Vcs.ToRevision)
+		}
+		writer.Flush()
+	}
+	for _, files := range []struct {
+		title string
+		diff  []FileDiff
+	}{{"Artifacts", diff.Artifacts}, {"Dependencies", diff.Dependencies}} {
+		if len(files.diff) == 0 {
+			continue
+		}
+		buffer.WriteString("\n" + files.title + ":\n")
+		writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "STATUS\tMODULE\tNAME\tFROM SHA1\tTO SHA1")
+		for _, file := range files.diff {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", file.Status, file.Module, file.Name, file.FromSha1, file.ToSha1)
+		}
+		writer.Flush()
+	}
+	if len(diff.Env) > 0 {
+		buffer.WriteString("\nEnvironment:\n")
+		writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "STATUS\tNAME\tFROM VALUE\tTO VALUE")
+		for _, env := range diff.Env {
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", env.Status, env.Name, env.FromValue, env.ToValue)
+		}
+		writer.Flush()
+	}
+	if len(diff.NewIssues) > 0 {
+		buffer.WriteString("\nNew issues:\n")
+		writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(writer, "KEY\tSUMMARY\tURL")
+		for _, issue := range diff.NewIssues {
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", issue.Key, issue.Summary, issue.Url)
+		}
+		writer.Flush()
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
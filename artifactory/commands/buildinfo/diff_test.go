@@ -0,0 +1,115 @@
+package buildinfo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestDiffBuildInfo(t *testing.T) {
+	from := &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "1",
+		Modules: []buildinfo.Module{{
+			Id:           "module",
+			Artifacts:    []buildinfo.Artifact{{Name: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}, {Name: "b.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}},
+			Dependencies: []buildinfo.Dependency{{Id: "dep:1", Checksum: &buildinfo.Checksum{Sha1: "3"}}},
+		}},
+		Properties: buildinfo.Env{"buildInfo.env.A": "1", "buildInfo.env.B": "2", "vcs.submodules.lib.revision": "1"},
+		Issues:     &buildinfo.Issues{AffectedIssues: []buildinfo.AffectedIssue{{Key: "JIRA-1"}}},
+		Vcs:        &buildinfo.Vcs{Url: "url", Revision: "abc"},
+	}
+	to := &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "2",
+		Modules: []buildinfo.Module{{
+			Id:           "module",
+			Artifacts:    []buildinfo.Artifact{{Name: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "4"}}, {Name: "c.jar", Checksum: &buildinfo.Checksum{Sha1: "5"}}},
+			Dependencies: []buildinfo.Dependency{{Id: "dep:1", Checksum: &buildinfo.Checksum{Sha1: "3"}}},
+		}},
+		Properties: buildinfo.Env{"buildInfo.env.A": "1", "buildInfo.env.B": "3", "buildInfo.env.C": "4", "vcs.submodules.lib.revision": "2", "vcs.branch": "master"},
+		Issues:     &buildinfo.Issues{AffectedIssues: []buildinfo.AffectedIssue{{Key: "JIRA-1"}, {Key: "JIRA-2", Summary: "Fix"}}},
+		Vcs:        &buildinfo.Vcs{Url: "url", Revision: "def"},
+	}
+	expected := &BuildDiff{
+		From: "build/1",
+		To:   "build/2",
+		Artifacts: []FileDiff{
+			{Module: "module", Name: "a.jar", Status: DiffChanged, FromSha1: "1", ToSha1: "4"},
+			{Module: "module", Name: "b.jar", Status: DiffRemoved, FromSha1: "2"},
+			{Module: "module", Name: "c.jar", Status: DiffAdded, ToSha1: "5"},
+		},
+		Dependencies: []FileDiff{},
+		Vcs:          &VcsDiff{FromUrl: "url", ToUrl: "url", FromRevision: "abc", ToRevision: "def"},
+		Env: []EnvDiff{
+			{Name: "buildInfo.env.B", Status: DiffChanged, FromValue: "2", ToValue: "3"},
+			{Name: "buildInfo.env.C", Status: DiffAdded, ToValue: "4"},
+		},
+		NewIssues: []IssueDiff{{Key: "JIRA-2", Summary: "Fix"}},
+	}
+	actual := diffBuildInfo(from, to)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %+v, got %+v.", expected, actual)
+	}
+	if !diffBuildInfo(from, from).IsEmpty() {
+		t.Error("Expected no differences between a build and itself.")
+	}
+}
+
+func TestDiffFilesOfNewVersions(t *testing.T) {
+	from := []moduleFile{
+		{"org:app:1.0", "app-1.0.jar", "1"},
+		{"org:app:1.0", "app-1.0.pom", "2"},
+		{"github.com/org/lib:v1.0.0", "v1.0.0.zip", "3"},
+	}
+	to := []moduleFile{
+		{"org:app:1.1", "app-1.1.jar", "4"},
+		{"org:app:1.1", "app-1.1.pom", "2"},
+		{"github.com/org/lib:v1.1.0", "v1.1.0.zip", "5"},
+	}
+	expected := []FileDiff{
+		{Module: "github.com/org/lib:v1.1.0", Name: "v1.1.0.zip", Status: DiffChanged, FromModule: "github.com/org/lib:v1.0.0", FromName: "v1.0.0.zip", FromSha1: "3", ToSha1: "5"},
+		{Module: "org:app:1.1", Name: "app-1.1.jar", Status: DiffChanged, FromModule: "org:app:1.0", FromName: "app-1.0.jar", FromSha1: "1", ToSha1: "4"},
+	}
+	// The pom did not change, although its module and name did.
+	if actual := diffFiles(from, to); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %+v, got %+v.", expected, actual)
+	}
+
+	// Dependencies are paired by their IDs without the versions. Files which could not be paired are removed and added.
+	from = []moduleFile{{"org:app:1.0", "org:dep:1.0", "1"}, {"org:app:1.0", "org:same:1.0", "2"}, {"org:app:1.0", "org:old:1.0", "6"}}
+	to = []moduleFile{{"org:app:1.1", "org:dep:2.0", "3"}, {"org:app:1.1", "org:same:1.0", "2"}, {"org:app:1.1", "org:new:1.0", "7"}}
+	expected = []FileDiff{
+		{Module: "org:app:1.0", Name: "org:old:1.0", Status: DiffRemoved, FromSha1: "6"},
+		{Module: "org:app:1.1", Name: "org:dep:2.0", Status: DiffChanged, FromModule: "org:app:1.0", FromName: "org:dep:1.0", FromSha1: "1", ToSha1: "3"},
+		{Module: "org:app:1.1", Name: "org:new:1.0", Status: DiffAdded, ToSha1: "7"},
+	}
+	if actual := diffFiles(from, to); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %+v, got %+v.", expected, actual)
+	}
+}
+
+func TestCreateBuildDiffOutput(t *testing.T) {
+	diff := &BuildDiff{From: "build/1", To: "build/2"}
+	expected := "No differences were found between build build/1 and build build/2."
+	if actual := CreateBuildDiffOutput(diff); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+
+	diff.Vcs = &VcsDiff{FromUrl: "url", ToUrl: "url", FromRevision: "abc", ToRevision: "def"}
+	diff.Artifacts = []FileDiff{{Module: "module", Name: "a.jar", Status: DiffChanged, FromSha1: "1", ToSha1: "4"}}
+	diff.Env = []EnvDiff{{Name: "buildInfo.env.A", Status: DiffChanged, FromValue: "1", ToValue: "2"}}
+	expected = "Comparing build build/1 with build build/2.\n" +
+		"\nVCS:\n" +
+		"  Revision:  abc -> def\n" +
+		"\nArtifacts:\n" +
+		"STATUS   MODULE  NAME   FROM SHA1  TO SHA1\n" +
+		"changed  module  a.jar  1          4\n" +
+		"\nEnvironment:\n" +
+		"STATUS   NAME             FROM VALUE  TO VALUE\n" +
+		"changed  buildInfo.env.A  1           2"
+	if actual := CreateBuildDiffOutput(diff); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
@@ -0,0 +1,52 @@
+package buildinfo
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+)
+
+// Lists the builds with build-info data collected locally, which were not published yet.
+type BuildListLocalCommand struct {
+	localBuilds []utils.LocalBuild
+}
+
+func NewBuildListLocalCommand() *BuildListLocalCommand {
+	return &BuildListLocalCommand{}
+}
+
+func (blc *BuildListLocalCommand) LocalBuilds() []utils.LocalBuild {
+	return blc.localBuilds
+}
+
+func (blc *BuildListLocalCommand) CommandName() string {
+	return "rt_build_list_local"
+}
+
+// Returns the default Artifactory server
+func (blc *BuildListLocalCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return config.GetDefaultArtifactoryConf()
+}
+
+func (blc *BuildListLocalCommand) Run() error {
+	var err error
+	blc.localBuilds, err = utils.GetLocalBuilds()
+	return err
+}
+
+// Shows the local builds as a table of their name, number, number of partials and age.
+func CreateLocalBuildsTable(localBuilds []utils.LocalBuild, now time.Time) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "BUILD NAME\tBUILD NUMBER\tPARTIALS\tAGE")
+	for _, localBuild := range localBuilds {
+		fmt.Fprintf(writer, "%s\t%s\t%d\t%s\n", localBuild.Name, localBuild.Number, localBuild.Partials, utils.FormatAge(now.Sub(localBuild.Started)))
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
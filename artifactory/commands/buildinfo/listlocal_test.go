@@ -0,0 +1,22 @@
+package buildinfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+)
+
+func TestCreateLocalBuildsTable(t *testing.T) {
+	now := time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC)
+	localBuilds := []utils.LocalBuild{
+		{Name: "build", Number: "1", Partials: 3, Started: now.Add(-50 * time.Hour)},
+		{Name: "other-build", Number: "12", Partials: 1, Started: now.Add(-5 * time.Minute)},
+	}
+	expected := "BUILD NAME   BUILD NUMBER  PARTIALS  AGE\n" +
+		"build        1             3         2d\n" +
+		"other-build  12            1         5m"
+	if actual := CreateLocalBuildsTable(localBuilds, now); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
@@ -0,0 +1,134 @@
+package buildinfo
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The ways of handling modules with the same ID in several merged builds.
+const (
+	MergeConflictFail   = "fail"
+	MergeConflictRename = "rename"
+	MergeConflictMerge  = "merge"
+)
+
+// Publishes a new build, which includes the modules and issues of several published builds.
+type BuildMergeCommand struct {
+	rtDetails          *config.ArtifactoryDetails
+	buildConfiguration *utils.BuildConfiguration
+	mergedBuilds       []*utils.BuildConfiguration
+	onConflict         string
+	dryRun             bool
+}
+
+func NewBuildMergeCommand() *BuildMergeCommand {
+	return &BuildMergeCommand{onConflict: MergeConflictFail}
+}
+
+func (bmc *BuildMergeCommand) SetRtDetails(rtDetails *config.ArtifactoryDetails) *BuildMergeCommand {
+	bmc.rtDetails = rtDetails
+	return bmc
+}
+
+func (bmc *BuildMergeCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *BuildMergeCommand {
+	bmc.buildConfiguration = buildConfiguration
+	return bmc
+}
+
+func (bmc *BuildMergeCommand) SetMergedBuilds(mergedBuilds []*utils.BuildConfiguration) *BuildMergeCommand {
+	bmc.mergedBuilds = mergedBuilds
+	return bmc
+}
+
+func (bmc *BuildMergeCommand) SetOnConflict(onConflict string) *BuildMergeCommand {
+	bmc.onConflict = onConflict
+	return bmc
+}
+
+func (bmc *BuildMergeCommand) SetDryRun(dryRun bool) *BuildMergeCommand {
+	bmc.dryRun = dryRun
+	return bmc
+}
+
+func (bmc *BuildMergeCommand) CommandName() string {
+	return "rt_build_merge"
+}
+
+func (bmc *BuildMergeCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return bmc.rtDetails, nil
+}
+
+func (bmc *BuildMergeCommand) Run() error {
+	servicesManager, err := utils.CreateServiceManager(bmc.rtDetails, bmc.dryRun)
+	if err != nil {
+		return err
+	}
+	var builds []*buildinfo.BuildInfo
+	for _, mergedBuild := range bmc.mergedBuilds {
+		build, err := getPublishedBuildInfo(mergedBuild, servicesManager)
+		if err != nil {
+			return err
+		}
+		builds = append(builds, build)
+	}
+	buildInfo := buildinfo.New()
+	buildInfo.SetAgentName(cliutils.ClientAgent)
+	buildInfo.SetAgentVersion(cliutils.GetVersion())
+	buildInfo.SetBuildAgentVersion(cliutils.GetVersion())
+	buildInfo.Name = bmc.buildConfiguration.BuildName
+	buildInfo.Number = bmc.buildConfiguration.BuildNumber
+	buildInfo.Started = time.Now().Format(BuildInfoStartedFormat)
+	buildInfo.ArtifactoryPrincipal = bmc.rtDetails.User
+	if err = mergeBuildInfo(buildInfo, builds, bmc.onConflict); err != nil {
+		return err
+	}
+	return servicesManager.PublishBuildInfo(buildInfo)
+}
+
+// Adds the modules and issues of the builds to the target build info.
+// Modules with the same ID in several builds fail the merge, are renamed to <build name>/<build number>/<module ID>,
+// or are merged into one module, according to onConflict.
+func mergeBuildInfo(target *buildinfo.BuildInfo, builds []*buildinfo.BuildInfo, onConflict string) error {
+	moduleBuilds := make(map[string]string)
+	issueKeys := make(map[string]bool)
+	for _, build := range builds {
+		buildId := build.Name + "/" + build.Number
+		for _, module := range build.Modules {
+			conflictingBuildId, exists := moduleBuilds[module.Id]
+			if !exists {
+				moduleBuilds[module.Id] = buildId
+				target.Modules = append(target.Modules, module)
+				continue
+			}
+			switch onConflict {
+			case MergeConflictRename:
+				module.Id = buildId + "/" + module.Id
+				moduleBuilds[module.Id] = buildId
+				target.Modules = append(target.Modules, module)
+			case MergeConflictMerge:
+				target.Append(&buildinfo.BuildInfo{Modules: []buildinfo.Module{module}})
+			default:
+				return errorutils.CheckError(errors.New("Module " + module.Id + " exists in both build " + conflictingBuildId + " and build " + buildId + "."))
+			}
+		}
+		if build.Issues == nil {
+			continue
+		}
+		if target.Issues == nil {
+			target.Issues = &buildinfo.Issues{Tracker: build.Issues.Tracker, AggregateBuildIssues: build.Issues.AggregateBuildIssues, AggregationBuildStatus: build.Issues.AggregationBuildStatus}
+		}
+		for _, issue := range build.Issues.AffectedIssues {
+			if !issueKeys[issue.Key] {
+				issueKeys[issue.Key] = true
+				target.Issues.AffectedIssues = append(target.Issues.AffectedIssues, issue)
+			}
+		}
+	}
+	return nil
+}
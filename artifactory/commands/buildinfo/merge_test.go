@@ -0,0 +1,66 @@
+package buildinfo
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func createMergedBuilds() []*buildinfo.BuildInfo {
+	return []*buildinfo.BuildInfo{
+		{
+			Name:    "a",
+			Number:  "1",
+			Modules: []buildinfo.Module{{Id: "common", Artifacts: []buildinfo.Artifact{{Name: "a.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}}}},
+			Issues:  &buildinfo.Issues{Tracker: &buildinfo.Tracker{Name: "JIRA"}, AffectedIssues: []buildinfo.AffectedIssue{{Key: "JIRA-1"}}},
+		},
+		{
+			Name:   "b",
+			Number: "2",
+			Modules: []buildinfo.Module{
+				{Id: "common", Artifacts: []buildinfo.Artifact{{Name: "b.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}}},
+				{Id: "b", Artifacts: []buildinfo.Artifact{{Name: "c.jar", Checksum: &buildinfo.Checksum{Sha1: "3"}}}},
+			},
+			Issues: &buildinfo.Issues{AffectedIssues: []buildinfo.AffectedIssue{{Key: "JIRA-1"}, {Key: "JIRA-2"}}},
+		},
+	}
+}
+
+func getModuleIds(build *buildinfo.BuildInfo) []string {
+	var ids []string
+	for _, module := range build.Modules {
+		ids = append(ids, module.Id)
+	}
+	return ids
+}
+
+func TestMergeBuildInfo(t *testing.T) {
+	target := buildinfo.New()
+	if err := mergeBuildInfo(target, createMergedBuilds(), MergeConflictFail); err == nil {
+		t.Error("Expected an error for a module which exists in both builds.")
+	}
+
+	target = buildinfo.New()
+	if err := mergeBuildInfo(target, createMergedBuilds(), MergeConflictRename); err != nil {
+		t.Fatal(err)
+	}
+	if ids := getModuleIds(target); !reflect.DeepEqual(ids, []string{"common", "b/2/common", "b"}) {
+		t.Errorf("Unexpected modules after renaming conflicting modules: %v", ids)
+	}
+	expectedIssues := &buildinfo.Issues{Tracker: &buildinfo.Tracker{Name: "JIRA"}, AffectedIssues: []buildinfo.AffectedIssue{{Key: "JIRA-1"}, {Key: "JIRA-2"}}}
+	if !reflect.DeepEqual(target.Issues, expectedIssues) {
+		t.Errorf("Expected issues %+v, got %+v.", expectedIssues, target.Issues)
+	}
+
+	target = buildinfo.New()
+	if err := mergeBuildInfo(target, createMergedBuilds(), MergeConflictMerge); err != nil {
+		t.Fatal(err)
+	}
+	if ids := getModuleIds(target); !reflect.DeepEqual(ids, []string{"common", "b"}) {
+		t.Errorf("Unexpected modules after merging conflicting modules: %v", ids)
+	}
+	if len(target.Modules[0].Artifacts) != 2 {
+		t.Errorf("Expected the merged module to include 2 artifacts, got %d.", len(target.Modules[0].Artifacts))
+	}
+}
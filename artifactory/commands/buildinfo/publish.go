@@ -1,21 +1,39 @@
 package buildinfo
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
 	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"io/ioutil"
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 )
 
+// The time format of the started field of the build info.
+const BuildInfoStartedFormat = "2006-01-02T15:04:05.000-0700"
+
 type BuildPublishCommand struct {
 	buildConfiguration *utils.BuildConfiguration
 	rtDetails          *config.ArtifactoryDetails
 	config             *buildinfo.Configuration
+	// If set, the build info is written to this file instead of being published.
+	outputFile string
+	// If set, the build info exported to this file is published, instead of the build info collected locally.
+	fromFile string
+	// If set, the build info is signed with this key, and the signature is uploaded to the signature repository.
+	signingKeyPath string
+	signingKeyId   string
+	signatureRepo  string
 }
 
 func NewBuildPublishCommand() *BuildPublishCommand {
@@ -37,6 +55,34 @@ func (bpc *BuildPublishCommand) SetBuildConfiguration(buildConfiguration *utils.
 	return bpc
 }
 
+func (bpc *BuildPublishCommand) SetOutputFile(outputFile string) *BuildPublishCommand {
+	bpc.outputFile = outputFile
+	return bpc
+}
+
+func (bpc *BuildPublishCommand) SetFromFile(fromFile string) *BuildPublishCommand {
+	bpc.fromFile = fromFile
+	return bpc
+}
+
+// Sets the private key used for signing the build info, which is either a GPG keyring or an ed25519 key.
+func (bpc *BuildPublishCommand) SetSigningKeyPath(signingKeyPath string) *BuildPublishCommand {
+	bpc.signingKeyPath = signingKeyPath
+	return bpc
+}
+
+// Sets the ID of the signing key, if the GPG keyring includes several private keys.
+func (bpc *BuildPublishCommand) SetSigningKeyId(signingKeyId string) *BuildPublishCommand {
+	bpc.signingKeyId = signingKeyId
+	return bpc
+}
+
+// Sets the repository the signature of the build info is uploaded to.
+func (bpc *BuildPublishCommand) SetSignatureRepo(signatureRepo string) *BuildPublishCommand {
+	bpc.signatureRepo = signatureRepo
+	return bpc
+}
+
 func (bpc *BuildPublishCommand) CommandName() string {
 	return "rt_build_publish"
 }
@@ -46,26 +92,21 @@ func (bpc *BuildPublishCommand) RtDetails() (*config.ArtifactoryDetails, error)
 }
 
 func (bpc *BuildPublishCommand) Run() error {
-	servicesManager, err := utils.CreateServiceManager(bpc.rtDetails, bpc.config.DryRun)
-	if err != nil {
-		return err
-	}
-
-	buildInfo, err := bpc.createBuildInfoFromPartials()
-	if err != nil {
-		return err
+	if bpc.fromFile != "" {
+		return bpc.publishFromFile()
 	}
 
-	generatedBuildsInfo, err := utils.GetGeneratedBuildsInfo(bpc.buildConfiguration.BuildName, bpc.buildConfiguration.BuildNumber)
+	buildInfo, err := bpc.createBuildInfo()
 	if err != nil {
 		return err
 	}
 
-	for _, v := range generatedBuildsInfo {
-		buildInfo.Append(v)
-	}
-
-	if err = servicesManager.PublishBuildInfo(buildInfo); err != nil {
+	if bpc.outputFile != "" {
+		if err = writeBuildInfoFile(buildInfo, bpc.outputFile); err != nil {
+			return err
+		}
+		log.Info("Build info saved to", bpc.outputFile+".")
+	} else if err = bpc.publishBuildInfo(buildInfo); err != nil {
 		return err
 	}
 
@@ -75,6 +116,22 @@ func (bpc *BuildPublishCommand) Run() error {
 	return nil
 }
 
+// Aggregates the partial build-info files and the build-info files generated by the build tools into the published build-info.
+func (bpc *BuildPublishCommand) createBuildInfo() (*buildinfo.BuildInfo, error) {
+	buildInfo, err := bpc.createBuildInfoFromPartials()
+	if err != nil {
+		return nil, err
+	}
+	generatedBuildsInfo, err := utils.GetGeneratedBuildsInfo(bpc.buildConfiguration.BuildName, bpc.buildConfiguration.BuildNumber)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range generatedBuildsInfo {
+		buildInfo.Append(v)
+	}
+	return buildInfo, nil
+}
+
 func (bpc *BuildPublishCommand) createBuildInfoFromPartials() (*buildinfo.BuildInfo, error) {
 	buildName := bpc.buildConfiguration.BuildName
 	buildNumber := bpc.buildConfiguration.BuildNumber
@@ -94,15 +151,25 @@ func (bpc *BuildPublishCommand) createBuildInfoFromPartials() (*buildinfo.BuildI
 	if err != nil {
 		return nil, err
 	}
-	buildInfo.Started = buildGeneralDetails.Timestamp.Format("2006-01-02T15:04:05.000-0700")
-	modules, env, vcs, issues, err := extractBuildInfoData(partials, createIncludeFilter(bpc.config.EnvInclude), createExcludeFilter(bpc.config.EnvExclude))
+	buildInfo.Started = buildGeneralDetails.Timestamp.Format(BuildInfoStartedFormat)
+	modules, env, vcs, vcsProperties, issues, err := extractBuildInfoData(partials, createIncludeFilter(bpc.config.EnvInclude), createExcludeFilter(bpc.config.EnvExclude))
 	if err != nil {
 		return nil, err
 	}
-	if len(env) != 0 {
-		buildInfo.Properties = env
+	// The build info has a single VCS field and no fields for the other VCS details, so they are published as build properties,
+	// next to the environment variables but in their own vcs. namespace.
+	if len(env) != 0 || len(vcsProperties) != 0 {
+		buildInfo.Properties = buildinfo.Env{}
+		for k, v := range env {
+			buildInfo.Properties[k] = v
+		}
+		for k, v := range vcsProperties {
+			buildInfo.Properties[k] = v
+		}
+	}
+	if bpc.rtDetails != nil {
+		buildInfo.ArtifactoryPrincipal = bpc.rtDetails.User
 	}
-	buildInfo.ArtifactoryPrincipal = bpc.rtDetails.User
 	buildInfo.BuildUrl = bpc.config.BuildUrl
 	if vcs != (buildinfo.Vcs{}) {
 		buildInfo.Revision = vcs.Revision
@@ -121,8 +188,116 @@ func (bpc *BuildPublishCommand) createBuildInfoFromPartials() (*buildinfo.BuildI
 	return buildInfo, nil
 }
 
-func extractBuildInfoData(partials buildinfo.Partials, includeFilter, excludeFilter filterFunc) ([]buildinfo.Module, buildinfo.Env, buildinfo.Vcs, buildinfo.Issues, error) {
+// Publishes a build info, which was written to a file by this or another machine.
+func (bpc *BuildPublishCommand) publishFromFile() error {
+	buildInfo, err := readBuildInfoFile(bpc.fromFile)
+	if err != nil {
+		return err
+	}
+	if bpc.buildConfiguration != nil && bpc.buildConfiguration.BuildName != "" &&
+		(bpc.buildConfiguration.BuildName != buildInfo.Name || bpc.buildConfiguration.BuildNumber != buildInfo.Number) {
+		return errorutils.CheckError(errors.New("The build info file " + bpc.fromFile + " is of build " + buildInfo.Name + "/" + buildInfo.Number +
+			", not of build " + bpc.buildConfiguration.BuildName + "/" + bpc.buildConfiguration.BuildNumber + "."))
+	}
+	if buildInfo.ArtifactoryPrincipal == "" && bpc.rtDetails != nil {
+		buildInfo.ArtifactoryPrincipal = bpc.rtDetails.User
+	}
+	return bpc.publishBuildInfo(buildInfo)
+}
+
+// Publishes the build info. If a signing key is set, the build info is signed before it is published, so that a wrong key fails
+// the command before anything is published, and the signature is uploaded once the build info is published.
+func (bpc *BuildPublishCommand) publishBuildInfo(buildInfo *buildinfo.BuildInfo) error {
+	var signer utils.Signer
+	var signature []byte
+	var err error
+	if bpc.signingKeyPath != "" {
+		if signer, err = utils.LoadSigner(bpc.signingKeyPath, bpc.signingKeyId); err != nil {
+			return err
+		}
+		if signature, err = signBuildInfo(buildInfo, signer); err != nil {
+			return err
+		}
+	}
+	servicesManager, err := utils.CreateServiceManager(bpc.rtDetails, bpc.config.DryRun)
+	if err != nil {
+		return err
+	}
+	if err = servicesManager.PublishBuildInfo(buildInfo); err != nil {
+		return err
+	}
+	if signer == nil {
+		return nil
+	}
+	return uploadBuildInfoSignature(servicesManager, buildInfo, signature, bpc.signatureRepo, signer.SignatureExtension())
+}
+
+func writeBuildInfoFile(buildInfo *buildinfo.BuildInfo, filePath string) error {
+	b, err := json.Marshal(buildInfo)
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	var content bytes.Buffer
+	err = json.Indent(&content, b, "", "  ")
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	return errorutils.CheckError(ioutil.WriteFile(filePath, content.Bytes(), 0644))
+}
+
+func readBuildInfoFile(filePath string) (*buildinfo.BuildInfo, error) {
+	content, err := fileutils.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	buildInfo := &buildinfo.BuildInfo{}
+	if err = json.Unmarshal(content, buildInfo); err != nil {
+		return nil, errorutils.CheckError(errors.New("The file " + filePath + " is not a valid build info: " + err.Error()))
+	}
+	if err = validateBuildInfo(buildInfo); err != nil {
+		return nil, errorutils.CheckError(errors.New("The file " + filePath + " is not a valid build info: " + err.Error()))
+	}
+	return buildInfo, nil
+}
+
+// Validates that the build info includes the fields required by Artifactory, and the details of the agent which created it.
+func validateBuildInfo(buildInfo *buildinfo.BuildInfo) error {
+	switch {
+	case buildInfo.Name == "":
+		return errors.New("the build name is missing")
+	case buildInfo.Number == "":
+		return errors.New("the build number is missing")
+	case buildInfo.Agent == nil || buildInfo.Agent.Name == "" || buildInfo.Agent.Version == "":
+		return errors.New("the agent name and version are missing")
+	case buildInfo.BuildAgent == nil || buildInfo.BuildAgent.Name == "":
+		return errors.New("the build agent name is missing")
+	}
+	if _, err := time.Parse(BuildInfoStartedFormat, buildInfo.Started); err != nil {
+		return errors.New("the build start time " + buildInfo.Started + " is not in the " + BuildInfoStartedFormat + " format")
+	}
+	for _, module := range buildInfo.Modules {
+		if module.Id == "" {
+			return errors.New("a module ID is missing")
+		}
+		for _, artifact := range module.Artifacts {
+			if artifact.Name == "" {
+				return errors.New("an artifact name is missing in module " + module.Id)
+			}
+		}
+		for _, dependency := range module.Dependencies {
+			if dependency.Id == "" {
+				return errors.New("a dependency ID is missing in module " + module.Id)
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the modules, the environment variables, the VCS details and the issues of the partials.
+// The VCS details which have no fields in the build info are returned as properties, separately from the environment variables.
+func extractBuildInfoData(partials buildinfo.Partials, includeFilter, excludeFilter filterFunc) ([]buildinfo.Module, buildinfo.Env, buildinfo.Vcs, buildinfo.Env, buildinfo.Issues, error) {
 	var vcs buildinfo.Vcs
+	var vcsProperties buildinfo.Env
 	var issues buildinfo.Issues
 	env := make(map[string]string)
 	partialModules := make(map[string]partialModule)
@@ -139,6 +314,14 @@ func extractBuildInfoData(partials buildinfo.Partials, includeFilter, excludeFil
 			}
 		case partial.Vcs != nil:
 			vcs = *partial.Vcs
+			// The VCS details which have no fields in the build info, added by build-add-git. They are replaced along with the VCS details
+			// if git is added again. They are not environment variables, so the include patterns don't apply to them, but they are
+			// excluded and redacted like them.
+			vcsPropertiesAfterExcludeFilter, e := excludeFilter(partial.Env)
+			if errorutils.CheckError(e) != nil {
+				return partialModulesToModules(partialModules), env, vcs, vcsProperties, issues, e
+			}
+			vcsProperties, _ = redactSecrets(vcsPropertiesAfterExcludeFilter)
 			if partial.Issues == nil {
 				continue
 			}
@@ -155,18 +338,18 @@ func extractBuildInfoData(partials buildinfo.Partials, includeFilter, excludeFil
 		case partial.Env != nil:
 			envAfterIncludeFilter, e := includeFilter(partial.Env)
 			if errorutils.CheckError(e) != nil {
-				return partialModulesToModules(partialModules), env, vcs, issues, e
+				return partialModulesToModules(partialModules), env, vcs, vcsProperties, issues, e
 			}
 			envAfterExcludeFilter, e := excludeFilter(envAfterIncludeFilter)
 			if errorutils.CheckError(e) != nil {
-				return partialModulesToModules(partialModules), env, vcs, issues, e
+				return partialModulesToModules(partialModules), env, vcs, vcsProperties, issues, e
 			}
 			for k, v := range envAfterExcludeFilter {
 				env[k] = v
 			}
 		}
 	}
-	return partialModulesToModules(partialModules), env, vcs, issuesMapToArray(issues, issuesMap), nil
+	return partialModulesToModules(partialModules), env, vcs, vcsProperties, issuesMapToArray(issues, issuesMap), nil
 }
 
 func partialModulesToModules(partialModules map[string]partialModule) []buildinfo.Module {
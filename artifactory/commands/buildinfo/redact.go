@@ -0,0 +1,113 @@
+package buildinfo
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+// Replaces the values of environment variables which look like secrets.
+const RedactedValue = "[REDACTED]"
+
+// The minimal length and Shannon entropy, in bits per character, of a value which looks like a random secret.
+// A random base64 string of 24 characters has an entropy of about 4.2.
+const (
+	highEntropyMinLength = 24
+	highEntropyThreshold = 4.0
+)
+
+// A known secret format, looked for in the values of the environment variables.
+type secretPattern struct {
+	name   string
+	regExp *regexp.Regexp
+}
+
+var secretPatterns = []secretPattern{
+	{"private key", regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY( BLOCK)?-----`)},
+	{"JWT", regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]*`)},
+	{"AWS access key", regexp.MustCompile(`\b(AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{"GitHub token", regexp.MustCompile(`\b(gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{"GitLab token", regexp.MustCompile(`\bglpat-[A-Za-z0-9_-]{20,}\b`)},
+	{"Slack token", regexp.MustCompile(`\bxox[abposr]-[A-Za-z0-9-]{10,}`)},
+	{"Google API key", regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{"npm token", regexp.MustCompile(`\bnpm_[A-Za-z0-9]{36}\b`)},
+	{"JFrog API key", regexp.MustCompile(`\bAKCp[A-Za-z0-9]{60,}\b`)},
+	{"Stripe key", regexp.MustCompile(`\b[sr]k_live_[A-Za-z0-9]{20,}\b`)},
+	{"credentials in URL", regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@]+:[^/\s@]+@`)},
+}
+
+// A high entropy value is a single token of these characters, which include the base64 and base64url alphabets.
+var highEntropyCandidate = regexp.MustCompile(`^[A-Za-z0-9+/=_-]+$`)
+
+// An environment variable whose value was redacted, and the reason it was detected as a secret.
+type RedactedEnv struct {
+	Name   string
+	Reason string
+}
+
+// Replaces the values which look like secrets with RedactedValue, and returns the redacted environment variables, sorted by name.
+func redactSecrets(env buildinfo.Env) (buildinfo.Env, []RedactedEnv) {
+	result := make(buildinfo.Env, len(env))
+	var redacted []RedactedEnv
+	for k, v := range env {
+		reason := detectSecret(v)
+		if reason == "" {
+			result[k] = v
+			continue
+		}
+		result[k] = RedactedValue
+		redacted = append(redacted, RedactedEnv{Name: k, Reason: reason})
+	}
+	sort.Slice(redacted, func(i, j int) bool {
+		return redacted[i].Name < redacted[j].Name
+	})
+	return result, redacted
+}
+
+// Returns the kind of secret the value looks like, or an empty string if it does not look like a secret.
+func detectSecret(value string) string {
+	for _, pattern := range secretPatterns {
+		if pattern.regExp.MatchString(value) {
+			return pattern.name
+		}
+	}
+	if isHighEntropy(value) {
+		return "high entropy string"
+	}
+	return ""
+}
+
+// Returns true if the value looks like a random token: long enough, mixing upper case letters, lower case letters and digits,
+// and with a high Shannon entropy. Hex strings, such as commit SHAs, and paths are not detected.
+func isHighEntropy(value string) bool {
+	if len(value) < highEntropyMinLength || !highEntropyCandidate.MatchString(value) {
+		return false
+	}
+	var hasUpper, hasLower, hasDigit bool
+	for _, c := range value {
+		hasUpper = hasUpper || unicode.IsUpper(c)
+		hasLower = hasLower || unicode.IsLower(c)
+		hasDigit = hasDigit || unicode.IsDigit(c)
+	}
+	if !hasUpper || !hasLower || !hasDigit || strings.Count(value, "/") > 1 {
+		return false
+	}
+	return shannonEntropy(value) >= highEntropyThreshold
+}
+
+func shannonEntropy(value string) float64 {
+	counts := make(map[rune]int)
+	for _, c := range value {
+		counts[c]++
+	}
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(len(value))
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
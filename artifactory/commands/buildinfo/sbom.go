@@ -0,0 +1,556 @@
+package buildinfo
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils/dependenciestree"
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The supported SBOM formats.
+const (
+	SbomFormatCycloneDx = "cyclonedx-json"
+	SbomFormatSpdx      = "spdx-json"
+)
+
+// The package types of dependencies identified by <name>:<version>, which can't be detected from the dependency ID.
+// The pip and NuGet commands record the package type in the properties of the modules they create.
+var SbomPackageTypes = []string{"generic", "golang", "npm", "nuget", "pypi"}
+
+func IsSbomPackageType(packageType string) bool {
+	for _, sbomPackageType := range SbomPackageTypes {
+		if packageType == sbomPackageType {
+			return true
+		}
+	}
+	return false
+}
+
+// The npm dependencies are identified by the name of their tarball.
+var npmTarballRegExp = regexp.MustCompile(`^(.+)-(\d+\.\d+\.\d+[^/]*)\.tgz$`)
+
+// The Maven and Gradle dependencies are identified by <group>:<artifact>:<version>.
+var mavenIdRegExp = regexp.MustCompile(`^([^:/]+):([^:/]+):([^:/]+)$`)
+
+// Exports the modules, artifacts and dependencies of a build as a Software Bill of Materials.
+type BuildExportSbomCommand struct {
+	rtDetails          *config.ArtifactoryDetails
+	buildConfiguration *utils.BuildConfiguration
+	// If true, the build-info is created from the data collected locally, instead of being fetched from Artifactory.
+	local       bool
+	config      *buildinfo.Configuration
+	format      string
+	packageType string
+	sbom        []byte
+}
+
+func NewBuildExportSbomCommand() *BuildExportSbomCommand {
+	return &BuildExportSbomCommand{format: SbomFormatCycloneDx}
+}
+
+func (besc *BuildExportSbomCommand) SetRtDetails(rtDetails *config.ArtifactoryDetails) *BuildExportSbomCommand {
+	besc.rtDetails = rtDetails
+	return besc
+}
+
+func (besc *BuildExportSbomCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *BuildExportSbomCommand {
+	besc.buildConfiguration = buildConfiguration
+	return besc
+}
+
+func (besc *BuildExportSbomCommand) SetLocal(local bool) *BuildExportSbomCommand {
+	besc.local = local
+	return besc
+}
+
+// The configuration used for creating the build-info of a local build.
+func (besc *BuildExportSbomCommand) SetConfig(config *buildinfo.Configuration) *BuildExportSbomCommand {
+	besc.config = config
+	return besc
+}
+
+func (besc *BuildExportSbomCommand) SetFormat(format string) *BuildExportSbomCommand {
+	besc.format = format
+	return besc
+}
+
+// Sets the package type of the dependencies identified by <name>:<version>, such as pypi or nuget dependencies,
+// in modules whose properties don't include the package type of their dependencies.
+func (besc *BuildExportSbomCommand) SetPackageType(packageType string) *BuildExportSbomCommand {
+	besc.packageType = packageType
+	return besc
+}
+
+func (besc *BuildExportSbomCommand) Sbom() []byte {
+	return besc.sbom
+}
+
+func (besc *BuildExportSbomCommand) CommandName() string {
+	return "rt_build_export_sbom"
+}
+
+func (besc *BuildExportSbomCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	if besc.rtDetails == nil {
+		return config.GetDefaultArtifactoryConf()
+	}
+	return besc.rtDetails, nil
+}
+
+func (besc *BuildExportSbomCommand) Run() error {
+	var buildInfo *buildinfo.BuildInfo
+	var err error
+	if besc.local {
+		buildInfo, err = createLocalBuildInfo(besc.buildConfiguration, besc.config)
+	} else {
+		var servicesManager *artifactory.ArtifactoryServicesManager
+		servicesManager, err = utils.CreateServiceManager(besc.rtDetails, false)
+		if err != nil {
+			return err
+		}
+		buildInfo, err = getPublishedBuildInfo(besc.buildConfiguration, servicesManager)
+	}
+	if err != nil {
+		return err
+	}
+	serialNumber, err := createUuid()
+	if err != nil {
+		return err
+	}
+	sbom := createSbom(buildInfo, besc.packageType)
+	switch besc.format {
+	case SbomFormatCycloneDx:
+		besc.sbom, err = sbom.toCycloneDx(serialNumber, time.Now())
+	case SbomFormatSpdx:
+		besc.sbom, err = sbom.toSpdx(serialNumber, time.Now())
+	default:
+		err = errorutils.CheckError(errors.New("Unsupported SBOM format " + besc.format + ". Possible values are: " + SbomFormatCycloneDx + ", " + SbomFormatSpdx + "."))
+	}
+	return err
+}
+
+// The build-info, in a form which can be converted to any of the SBOM formats.
+type sbom struct {
+	buildName   string
+	buildNumber string
+	modules     []sbomModule
+	// All the dependencies of the build, sorted by their package URL.
+	packages []*sbomPackage
+}
+
+type sbomModule struct {
+	id        string
+	artifacts []sbomPackage
+	// The direct dependencies of the module. If the dependency graph of the module is unknown, all its dependencies are direct.
+	dependencies []*sbomPackage
+}
+
+type sbomPackage struct {
+	name    string
+	version string
+	purl    string
+	sha1    string
+	md5     string
+	// The package URLs of the dependencies of the package, sorted.
+	dependencies []string
+}
+
+func createSbom(buildInfo *buildinfo.BuildInfo, packageType string) *sbom {
+	result := &sbom{buildName: buildInfo.Name, buildNumber: buildInfo.Number}
+	packages := make(map[string]*sbomPackage)
+	for _, module := range buildInfo.Modules {
+		sbomModule := sbomModule{id: module.Id}
+		for _, artifact := range module.Artifacts {
+			sha1, md5 := getChecksums(artifact.Checksum)
+			sbomModule.artifacts = append(sbomModule.artifacts, sbomPackage{name: artifact.Name, sha1: sha1, md5: md5})
+		}
+		// The package type and the dependency graph are kept in the module properties by the package managers which resolve them,
+		// such as NuGet and pip.
+		childrenMap := dependenciestree.ReadChildrenProperties(module.Properties)
+		modulePackageType := dependenciestree.ReadPackageTypeProperty(module.Properties)
+		if modulePackageType == "" {
+			modulePackageType = packageType
+		}
+		dependencyPurls := make(map[string]string)
+		moduleDependencies := make(map[string]bool)
+		for _, dependency := range module.Dependencies {
+			dependencyPackage := createDependencyPackage(dependency, modulePackageType)
+			existing, exists := packages[dependencyPackage.purl]
+			if !exists {
+				packages[dependencyPackage.purl] = dependencyPackage
+				existing = dependencyPackage
+			} else if existing.sha1 != dependencyPackage.sha1 {
+				// A package may be recorded as several files, such as the mod and zip files of a Go module, so no single checksum identifies it.
+				existing.sha1, existing.md5 = "", ""
+			}
+			dependencyPurls[dependency.Id] = existing.purl
+			if !moduleDependencies[existing.purl] {
+				moduleDependencies[existing.purl] = true
+				sbomModule.dependencies = append(sbomModule.dependencies, existing)
+			}
+		}
+		sbomModule.dependencies = addDependencyGraph(sbomModule.dependencies, packages, dependencyPurls, childrenMap)
+		sort.Slice(sbomModule.dependencies, func(i, j int) bool {
+			return sbomModule.dependencies[i].purl < sbomModule.dependencies[j].purl
+		})
+		result.modules = append(result.modules, sbomModule)
+	}
+	sort.Slice(result.modules, func(i, j int) bool {
+		return result.modules[i].id < result.modules[j].id
+	})
+	for _, dependencyPackage := range packages {
+		result.packages = append(result.packages, dependencyPackage)
+	}
+	sort.Slice(result.packages, func(i, j int) bool {
+		return result.packages[i].purl < result.packages[j].purl
+	})
+	return result
+}
+
+// Adds the dependencies of each package of a module, according to the IDs of the children of each dependency,
+// and returns the direct dependencies of the module, which are not dependencies of any of its other dependencies.
+func addDependencyGraph(moduleDependencies []*sbomPackage, packages map[string]*sbomPackage, dependencyPurls map[string]string, childrenMap map[string][]string) []*sbomPackage {
+	indirect := make(map[string]bool)
+	for parentId, childrenIds := range childrenMap {
+		parent, ok := packages[dependencyPurls[parentId]]
+		if !ok {
+			continue
+		}
+		for _, childId := range childrenIds {
+			childPurl, ok := dependencyPurls[childId]
+			if !ok || childPurl == parent.purl {
+				continue
+			}
+			indirect[childPurl] = true
+			if !containsString(parent.dependencies, childPurl) {
+				parent.dependencies = append(parent.dependencies, childPurl)
+			}
+		}
+		sort.Strings(parent.dependencies)
+	}
+	var direct []*sbomPackage
+	for _, dependency := range moduleDependencies {
+		if !indirect[dependency.purl] {
+			direct = append(direct, dependency)
+		}
+	}
+	// If all the dependencies depend on each other, none of them is direct, so the module depends on all of them.
+	if len(direct) == 0 {
+		return moduleDependencies
+	}
+	return direct
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func getChecksums(checksum *buildinfo.Checksum) (sha1, md5 string) {
+	if checksum == nil {
+		return "", ""
+	}
+	return checksum.Sha1, checksum.Md5
+}
+
+// Converts a build-info dependency to a package with a package URL (purl).
+// npm dependencies are identified by their tarball name, Maven and Gradle dependencies by <group>:<artifact>:<version>,
+// and Go modules by <module path>:<version>. Other dependencies identified by <name>:<version>, such as pip and NuGet dependencies, get the specified package type.
+// Any other dependency is a generic file, identified by its name and checksum.
+func createDependencyPackage(dependency buildinfo.Dependency, packageType string) *sbomPackage {
+	sha1, md5 := getChecksums(dependency.Checksum)
+	dependencyPackage := &sbomPackage{name: dependency.Id, sha1: sha1, md5: md5}
+	if match := npmTarballRegExp.FindStringSubmatch(dependency.Id); match != nil {
+		dependencyPackage.name, dependencyPackage.version = match[1], match[2]
+		dependencyPackage.purl = createPurl("npm", dependencyPackage.name, dependencyPackage.version)
+		return dependencyPackage
+	}
+	if match := mavenIdRegExp.FindStringSubmatch(dependency.Id); match != nil {
+		dependencyPackage.name, dependencyPackage.version = match[1]+":"+match[2], match[3]
+		dependencyPackage.purl = createPurl("maven", match[1]+"/"+match[2], dependencyPackage.version)
+		return dependencyPackage
+	}
+	if i := strings.LastIndex(dependency.Id, ":"); i > 0 && i < len(dependency.Id)-1 {
+		dependencyPackage.name, dependencyPackage.version = dependency.Id[:i], dependency.Id[i+1:]
+		switch {
+		case strings.Contains(dependencyPackage.name, "/") && strings.HasPrefix(dependencyPackage.version, "v"):
+			dependencyPackage.purl = createPurl("golang", dependencyPackage.name, dependencyPackage.version)
+		case packageType == "pypi":
+			// Python package names are case insensitive, and normalized to lower case with dashes.
+			dependencyPackage.purl = createPurl(packageType, strings.Replace(strings.ToLower(dependencyPackage.name), "_", "-", -1), dependencyPackage.version)
+		case packageType != "":
+			dependencyPackage.purl = createPurl(packageType, dependencyPackage.name, dependencyPackage.version)
+		default:
+			dependencyPackage.purl = createPurl("generic", dependencyPackage.name, dependencyPackage.version)
+		}
+		return dependencyPackage
+	}
+	dependencyPackage.purl = createPurl("generic", dependencyPackage.name, "")
+	if sha1 != "" {
+		dependencyPackage.purl += "?checksum=sha1:" + sha1
+	}
+	return dependencyPackage
+}
+
+func createPurl(packageType, name, version string) string {
+	segments := strings.Split(name, "/")
+	for i, segment := range segments {
+		// The @ prefix of npm scopes must be encoded as well.
+		segments[i] = strings.Replace(url.PathEscape(segment), "@", "%40", -1)
+	}
+	purl := "pkg:" + packageType + "/" + strings.Join(segments, "/")
+	if version != "" {
+		purl += "@" + url.PathEscape(version)
+	}
+	return purl
+}
+
+// Creates a random (version 4) UUID.
+func createUuid() (string, error) {
+	uuid := make([]byte, 16)
+	if _, err := rand.Read(uuid); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	uuid[6] = (uuid[6] & 0x0f) | 0x40
+	uuid[8] = (uuid[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", uuid[0:4], uuid[4:6], uuid[6:8], uuid[8:10], uuid[10:]), nil
+}
+
+type cycloneDxBom struct {
+	BomFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     cycloneDxMetadata     `json:"metadata"`
+	Components   []cycloneDxComponent  `json:"components"`
+	Dependencies []cycloneDxDependency `json:"dependencies"`
+}
+
+type cycloneDxMetadata struct {
+	Timestamp string             `json:"timestamp"`
+	Tools     []cycloneDxTool    `json:"tools"`
+	Component cycloneDxComponent `json:"component"`
+}
+
+type cycloneDxTool struct {
+	Vendor  string `json:"vendor"`
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type cycloneDxComponent struct {
+	Type       string               `json:"type"`
+	BomRef     string               `json:"bom-ref"`
+	Name       string               `json:"name"`
+	Version    string               `json:"version,omitempty"`
+	Purl       string               `json:"purl,omitempty"`
+	Hashes     []cycloneDxHash      `json:"hashes,omitempty"`
+	Components []cycloneDxComponent `json:"components,omitempty"`
+}
+
+type cycloneDxHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+type cycloneDxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// Creates a CycloneDX 1.4 JSON document. Each module is an application component, which includes its artifacts as file components,
+// and depends on its direct dependencies, which depend on their own dependencies.
+func (sbom *sbom) toCycloneDx(serialNumber string, timestamp time.Time) ([]byte, error) {
+	buildRef := "build:" + sbom.buildName + "/" + sbom.buildNumber
+	bom := cycloneDxBom{
+		BomFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + serialNumber,
+		Version:      1,
+		Metadata: cycloneDxMetadata{
+			Timestamp: timestamp.UTC().Format(time.RFC3339),
+			Tools:     []cycloneDxTool{{Vendor: "JFrog", Name: cliutils.ClientAgent, Version: cliutils.GetVersion()}},
+			Component: cycloneDxComponent{Type: "application", BomRef: buildRef, Name: sbom.buildName, Version: sbom.buildNumber},
+		},
+		Components:   []cycloneDxComponent{},
+		Dependencies: []cycloneDxDependency{},
+	}
+	buildDependency := cycloneDxDependency{Ref: buildRef, DependsOn: []string{}}
+	for _, module := range sbom.modules {
+		moduleRef := "module:" + module.id
+		moduleComponent := cycloneDxComponent{Type: "application", BomRef: moduleRef, Name: module.id}
+		for _, artifact := range module.artifacts {
+			moduleComponent.Components = append(moduleComponent.Components, cycloneDxComponent{
+				Type:   "file",
+				BomRef: moduleRef + "/" + artifact.name,
+				Name:   artifact.name,
+				Hashes: createCycloneDxHashes(artifact),
+			})
+		}
+		bom.Components = append(bom.Components, moduleComponent)
+		buildDependency.DependsOn = append(buildDependency.DependsOn, moduleRef)
+		moduleDependency := cycloneDxDependency{Ref: moduleRef, DependsOn: []string{}}
+		for _, dependency := range module.dependencies {
+			moduleDependency.DependsOn = append(moduleDependency.DependsOn, dependency.purl)
+		}
+		bom.Dependencies = append(bom.Dependencies, moduleDependency)
+	}
+	for _, dependency := range sbom.packages {
+		bom.Components = append(bom.Components, cycloneDxComponent{
+			Type:    "library",
+			BomRef:  dependency.purl,
+			Name:    dependency.name,
+			Version: dependency.version,
+			Purl:    dependency.purl,
+			Hashes:  createCycloneDxHashes(*dependency),
+		})
+		if len(dependency.dependencies) > 0 {
+			bom.Dependencies = append(bom.Dependencies, cycloneDxDependency{Ref: dependency.purl, DependsOn: dependency.dependencies})
+		}
+	}
+	bom.Dependencies = append([]cycloneDxDependency{buildDependency}, bom.Dependencies...)
+	return marshalSbom(bom)
+}
+
+func createCycloneDxHashes(sbomPackage sbomPackage) []cycloneDxHash {
+	var hashes []cycloneDxHash
+	if sbomPackage.sha1 != "" {
+		hashes = append(hashes, cycloneDxHash{Alg: "SHA-1", Content: sbomPackage.sha1})
+	}
+	if sbomPackage.md5 != "" {
+		hashes = append(hashes, cycloneDxHash{Alg: "MD5", Content: sbomPackage.md5})
+	}
+	return hashes
+}
+
+type spdxDocument struct {
+	SpdxVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SpdxId            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	SpdxId           string            `json:"SPDXID"`
+	Name             string            `json:"name"`
+	VersionInfo      string            `json:"versionInfo,omitempty"`
+	DownloadLocation string            `json:"downloadLocation"`
+	FilesAnalyzed    bool              `json:"filesAnalyzed"`
+	LicenseConcluded string            `json:"licenseConcluded"`
+	LicenseDeclared  string            `json:"licenseDeclared"`
+	CopyrightText    string            `json:"copyrightText"`
+	Checksums        []spdxChecksum    `json:"checksums,omitempty"`
+	ExternalRefs     []spdxExternalRef `json:"externalRefs,omitempty"`
+}
+
+type spdxChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SpdxElementId      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSpdxElement string `json:"relatedSpdxElement"`
+}
+
+// Creates an SPDX 2.2 JSON document. The document describes the modules, which contain their artifacts and depend on their direct dependencies,
+// which depend on their own dependencies.
+// The SPDX IDs are generated, since they may only include letters, numbers, dots and dashes.
+func (sbom *sbom) toSpdx(namespaceUuid string, timestamp time.Time) ([]byte, error) {
+	document := spdxDocument{
+		SpdxVersion:       "SPDX-2.2",
+		DataLicense:       "CC0-1.0",
+		SpdxId:            "SPDXRef-DOCUMENT",
+		Name:              sbom.buildName + "/" + sbom.buildNumber,
+		DocumentNamespace: "https://jfrog.com/spdx/" + url.PathEscape(sbom.buildName) + "/" + url.PathEscape(sbom.buildNumber) + "-" + namespaceUuid,
+		CreationInfo: spdxCreationInfo{
+			Created:  timestamp.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + cliutils.ClientAgent + "-" + cliutils.GetVersion()},
+		},
+		Packages:      []spdxPackage{},
+		Relationships: []spdxRelationship{},
+	}
+	dependencyIds := make(map[string]string)
+	for i, dependency := range sbom.packages {
+		dependencyIds[dependency.purl] = "SPDXRef-Package-" + strconv.Itoa(i+1)
+		spdxPackage := createSpdxPackage(dependencyIds[dependency.purl], *dependency)
+		spdxPackage.ExternalRefs = []spdxExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: dependency.purl}}
+		document.Packages = append(document.Packages, spdxPackage)
+	}
+	for i, module := range sbom.modules {
+		moduleId := "SPDXRef-Module-" + strconv.Itoa(i+1)
+		document.Packages = append(document.Packages, createSpdxPackage(moduleId, sbomPackage{name: module.id, version: sbom.buildNumber}))
+		document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: document.SpdxId, RelationshipType: "DESCRIBES", RelatedSpdxElement: moduleId})
+		for j, artifact := range module.artifacts {
+			artifactId := moduleId + "-Artifact-" + strconv.Itoa(j+1)
+			document.Packages = append(document.Packages, createSpdxPackage(artifactId, artifact))
+			document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: moduleId, RelationshipType: "CONTAINS", RelatedSpdxElement: artifactId})
+		}
+		for _, dependency := range module.dependencies {
+			document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: moduleId, RelationshipType: "DEPENDS_ON", RelatedSpdxElement: dependencyIds[dependency.purl]})
+		}
+	}
+	for _, dependency := range sbom.packages {
+		for _, child := range dependency.dependencies {
+			document.Relationships = append(document.Relationships, spdxRelationship{SpdxElementId: dependencyIds[dependency.purl], RelationshipType: "DEPENDS_ON", RelatedSpdxElement: dependencyIds[child]})
+		}
+	}
+	return marshalSbom(document)
+}
+
+func createSpdxPackage(spdxId string, sbomPackage sbomPackage) spdxPackage {
+	result := spdxPackage{
+		SpdxId:           spdxId,
+		Name:             sbomPackage.name,
+		VersionInfo:      sbomPackage.version,
+		DownloadLocation: "NOASSERTION",
+		LicenseConcluded: "NOASSERTION",
+		LicenseDeclared:  "NOASSERTION",
+		CopyrightText:    "NOASSERTION",
+	}
+	if sbomPackage.sha1 != "" {
+		result.Checksums = append(result.Checksums, spdxChecksum{Algorithm: "SHA1", ChecksumValue: sbomPackage.sha1})
+	}
+	if sbomPackage.md5 != "" {
+		result.Checksums = append(result.Checksums, spdxChecksum{Algorithm: "MD5", ChecksumValue: sbomPackage.md5})
+	}
+	return result
+}
+
+func marshalSbom(document interface{}) ([]byte, error) {
+	content, err := json.MarshalIndent(document, "", "  ")
+	return content, errorutils.CheckError(err)
+}
@@ -0,0 +1,221 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils/dependenciestree"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestCreateDependencyPackage(t *testing.T) {
+	tests := []struct {
+		id          string
+		packageType string
+		purl        string
+	}{
+		{"lodash-4.17.15.tgz", "", "pkg:npm/lodash@4.17.15"},
+		{"github.com/pkg/errors:v0.8.1", "", "pkg:golang/github.com/pkg/errors@v0.8.1"},
+		{"Newtonsoft.Json:12.0.1", "nuget", "pkg:nuget/Newtonsoft.Json@12.0.1"},
+		{"Python_Dateutil:2.8.0", "pypi", "pkg:pypi/python-dateutil@2.8.0"},
+		{"@angular/core:8.0.0", "npm", "pkg:npm/%40angular/core@8.0.0"},
+		{"org.acme:lib:1.0", "", "pkg:maven/org.acme/lib@1.0"},
+		{"org.acme:lib:1.0", "pypi", "pkg:maven/org.acme/lib@1.0"},
+		{"dep:1.0", "", "pkg:generic/dep@1.0"},
+		{"file.zip", "", "pkg:generic/file.zip?checksum=sha1:123"},
+	}
+	for _, test := range tests {
+		dependencyPackage := createDependencyPackage(buildinfo.Dependency{Id: test.id, Checksum: &buildinfo.Checksum{Sha1: "123"}}, test.packageType)
+		if dependencyPackage.purl != test.purl {
+			t.Errorf("Expected the package URL of %s to be %s, got %s.", test.id, test.purl, dependencyPackage.purl)
+		}
+	}
+}
+
+func createSbomTestBuildInfo() *buildinfo.BuildInfo {
+	return &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "1",
+		Modules: []buildinfo.Module{{
+			Id:        "module",
+			Artifacts: []buildinfo.Artifact{{Name: "module.zip", Checksum: &buildinfo.Checksum{Sha1: "1", Md5: "2"}}},
+			// The mod and zip files of a Go module have the same ID.
+			Dependencies: []buildinfo.Dependency{
+				{Id: "github.com/pkg/errors:v0.8.1", Checksum: &buildinfo.Checksum{Sha1: "3"}},
+				{Id: "github.com/pkg/errors:v0.8.1", Checksum: &buildinfo.Checksum{Sha1: "4"}},
+				{Id: "lodash-4.17.15.tgz", Checksum: &buildinfo.Checksum{Sha1: "5", Md5: "6"}},
+			},
+		}},
+	}
+}
+
+func TestToCycloneDx(t *testing.T) {
+	content, err := createSbom(createSbomTestBuildInfo(), "").toCycloneDx("uuid", time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bom := &cycloneDxBom{}
+	if err = json.Unmarshal(content, bom); err != nil {
+		t.Fatal(err)
+	}
+	if bom.SerialNumber != "urn:uuid:uuid" || bom.Metadata.Timestamp != "2019-01-02T03:04:05Z" || bom.Metadata.Component.Name != "build" {
+		t.Errorf("Unexpected BOM metadata: %+v", bom)
+	}
+	expectedComponents := []cycloneDxComponent{
+		{Type: "application", BomRef: "module:module", Name: "module", Components: []cycloneDxComponent{
+			{Type: "file", BomRef: "module:module/module.zip", Name: "module.zip", Hashes: []cycloneDxHash{{Alg: "SHA-1", Content: "1"}, {Alg: "MD5", Content: "2"}}},
+		}},
+		{Type: "library", BomRef: "pkg:golang/github.com/pkg/errors@v0.8.1", Name: "github.com/pkg/errors", Version: "v0.8.1", Purl: "pkg:golang/github.com/pkg/errors@v0.8.1"},
+		{Type: "library", BomRef: "pkg:npm/lodash@4.17.15", Name: "lodash", Version: "4.17.15", Purl: "pkg:npm/lodash@4.17.15", Hashes: []cycloneDxHash{{Alg: "SHA-1", Content: "5"}, {Alg: "MD5", Content: "6"}}},
+	}
+	if !reflect.DeepEqual(bom.Components, expectedComponents) {
+		t.Errorf("Expected components:\n%+v\nGot:\n%+v", expectedComponents, bom.Components)
+	}
+	expectedDependencies := []cycloneDxDependency{
+		{Ref: "build:build/1", DependsOn: []string{"module:module"}},
+		{Ref: "module:module", DependsOn: []string{"pkg:golang/github.com/pkg/errors@v0.8.1", "pkg:npm/lodash@4.17.15"}},
+	}
+	if !reflect.DeepEqual(bom.Dependencies, expectedDependencies) {
+		t.Errorf("Expected dependencies:\n%+v\nGot:\n%+v", expectedDependencies, bom.Dependencies)
+	}
+}
+
+func TestToSpdx(t *testing.T) {
+	content, err := createSbom(createSbomTestBuildInfo(), "").toSpdx("uuid", time.Date(2019, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	document := &spdxDocument{}
+	if err = json.Unmarshal(content, document); err != nil {
+		t.Fatal(err)
+	}
+	if document.DocumentNamespace != "https://jfrog.com/spdx/build/1-uuid" || document.CreationInfo.Created != "2019-01-02T03:04:05Z" {
+		t.Errorf("Unexpected document information: %+v", document)
+	}
+	var ids []string
+	for _, spdxPackage := range document.Packages {
+		ids = append(ids, spdxPackage.SpdxId)
+	}
+	expectedIds := []string{"SPDXRef-Package-1", "SPDXRef-Package-2", "SPDXRef-Module-1", "SPDXRef-Module-1-Artifact-1"}
+	if !reflect.DeepEqual(ids, expectedIds) {
+		t.Errorf("Expected packages %v, got %v.", expectedIds, ids)
+	}
+	expectedRelationships := []spdxRelationship{
+		{SpdxElementId: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSpdxElement: "SPDXRef-Module-1"},
+		{SpdxElementId: "SPDXRef-Module-1", RelationshipType: "CONTAINS", RelatedSpdxElement: "SPDXRef-Module-1-Artifact-1"},
+		{SpdxElementId: "SPDXRef-Module-1", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-1"},
+		{SpdxElementId: "SPDXRef-Module-1", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-2"},
+	}
+	if !reflect.DeepEqual(document.Relationships, expectedRelationships) {
+		t.Errorf("Expected relationships:\n%+v\nGot:\n%+v", expectedRelationships, document.Relationships)
+	}
+}
+
+func TestExportSbomOfMissingBuild(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, "Not Found")
+	}))
+	defer ts.Close()
+	command := NewBuildExportSbomCommand().SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"}).
+		SetBuildConfiguration(&utils.BuildConfiguration{BuildName: "build", BuildNumber: "1"})
+	if err := command.Run(); err == nil {
+		t.Error("Expected an error when the build-info can't be fetched from Artifactory.")
+	}
+	if command.Sbom() != nil {
+		t.Error("Expected no SBOM when the build-info can't be fetched from Artifactory.")
+	}
+}
+
+func TestSbomDependencyGraph(t *testing.T) {
+	// The module properties of a build-info read from JSON are a map of interface values.
+	buildInfo := &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "1",
+		Modules: []buildinfo.Module{{
+			Id: "module",
+			Properties: map[string]interface{}{
+				dependenciestree.ChildrenPropertyPrefix + "Parent:1.0": "Child:2.0,Other:3.0",
+				dependenciestree.ChildrenPropertyPrefix + "Child:2.0":  "Other:3.0",
+			},
+			Dependencies: []buildinfo.Dependency{{Id: "Parent:1.0"}, {Id: "Child:2.0"}, {Id: "Other:3.0"}},
+		}},
+	}
+	sbom := createSbom(buildInfo, "nuget")
+	content, err := sbom.toCycloneDx("uuid", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	bom := &cycloneDxBom{}
+	if err = json.Unmarshal(content, bom); err != nil {
+		t.Fatal(err)
+	}
+	expectedDependencies := []cycloneDxDependency{
+		{Ref: "build:build/1", DependsOn: []string{"module:module"}},
+		{Ref: "module:module", DependsOn: []string{"pkg:nuget/Parent@1.0"}},
+		{Ref: "pkg:nuget/Child@2.0", DependsOn: []string{"pkg:nuget/Other@3.0"}},
+		{Ref: "pkg:nuget/Parent@1.0", DependsOn: []string{"pkg:nuget/Child@2.0", "pkg:nuget/Other@3.0"}},
+	}
+	if !reflect.DeepEqual(bom.Dependencies, expectedDependencies) {
+		t.Errorf("Expected dependencies:\n%+v\nGot:\n%+v", expectedDependencies, bom.Dependencies)
+	}
+
+	content, err = sbom.toSpdx("uuid", time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	document := &spdxDocument{}
+	if err = json.Unmarshal(content, document); err != nil {
+		t.Fatal(err)
+	}
+	// The packages are sorted by their package URLs: Child, Other and Parent.
+	expectedRelationships := []spdxRelationship{
+		{SpdxElementId: "SPDXRef-DOCUMENT", RelationshipType: "DESCRIBES", RelatedSpdxElement: "SPDXRef-Module-1"},
+		{SpdxElementId: "SPDXRef-Module-1", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-3"},
+		{SpdxElementId: "SPDXRef-Package-1", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-2"},
+		{SpdxElementId: "SPDXRef-Package-3", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-1"},
+		{SpdxElementId: "SPDXRef-Package-3", RelationshipType: "DEPENDS_ON", RelatedSpdxElement: "SPDXRef-Package-2"},
+	}
+	if !reflect.DeepEqual(document.Relationships, expectedRelationships) {
+		t.Errorf("Expected relationships:\n%+v\nGot:\n%+v", expectedRelationships, document.Relationships)
+	}
+}
+
+func TestSbomModulePackageTypes(t *testing.T) {
+	buildInfo := &buildinfo.BuildInfo{
+		Name:   "build",
+		Number: "1",
+		Modules: []buildinfo.Module{
+			{
+				Id:           "pip-module",
+				Properties:   map[string]interface{}{dependenciestree.PackageTypeProperty: "pypi"},
+				Dependencies: []buildinfo.Dependency{{Id: "Requests:2.22.0"}},
+			},
+			{
+				Id:           "nuget-module",
+				Properties:   map[string]interface{}{dependenciestree.PackageTypeProperty: "nuget"},
+				Dependencies: []buildinfo.Dependency{{Id: "Newtonsoft.Json:12.0.1"}},
+			},
+			{
+				Id:           "org.acme:app:1.0",
+				Dependencies: []buildinfo.Dependency{{Id: "org.acme:lib:1.0"}, {Id: "dep:1.0"}},
+			},
+		},
+	}
+	// The package type of the command applies only to the module without a recorded package type.
+	var purls []string
+	for _, dependencyPackage := range createSbom(buildInfo, "npm").packages {
+		purls = append(purls, dependencyPackage.purl)
+	}
+	expected := []string{"pkg:maven/org.acme/lib@1.0", "pkg:npm/dep@1.0", "pkg:nuget/Newtonsoft.Json@12.0.1", "pkg:pypi/requests@2.22.0"}
+	if !reflect.DeepEqual(purls, expected) {
+		t.Errorf("Expected the package URLs %v, got %v.", expected, purls)
+	}
+}
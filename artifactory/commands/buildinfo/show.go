@@ -0,0 +1,158 @@
+package buildinfo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Creates the build-info from the data collected locally, in the same way as the build-publish command, without publishing it.
+type BuildShowCommand struct {
+	buildConfiguration *utils.BuildConfiguration
+	config             *buildinfo.Configuration
+	buildInfo          *buildinfo.BuildInfo
+}
+
+func NewBuildShowCommand() *BuildShowCommand {
+	return &BuildShowCommand{}
+}
+
+func (bsc *BuildShowCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *BuildShowCommand {
+	bsc.buildConfiguration = buildConfiguration
+	return bsc
+}
+
+func (bsc *BuildShowCommand) SetConfig(config *buildinfo.Configuration) *BuildShowCommand {
+	bsc.config = config
+	return bsc
+}
+
+// Returns the build-info, with its modules, artifacts and dependencies sorted.
+func (bsc *BuildShowCommand) BuildInfo() *buildinfo.BuildInfo {
+	return bsc.buildInfo
+}
+
+func (bsc *BuildShowCommand) CommandName() string {
+	return "rt_build_show"
+}
+
+// Returns the default Artifactory server
+func (bsc *BuildShowCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return config.GetDefaultArtifactoryConf()
+}
+
+func (bsc *BuildShowCommand) Run() error {
+	var err error
+	bsc.buildInfo, err = createLocalBuildInfo(bsc.buildConfiguration, bsc.config)
+	return err
+}
+
+// Creates the build-info of a build which was not published yet, from the data collected locally.
+func createLocalBuildInfo(buildConfiguration *utils.BuildConfiguration, config *buildinfo.Configuration) (*buildinfo.BuildInfo, error) {
+	buildName, buildNumber := buildConfiguration.BuildName, buildConfiguration.BuildNumber
+	exists, err := utils.IsBuildDirExists(buildName, buildNumber)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errorutils.CheckError(errors.New("No build-info data was collected locally for build " + buildName + "/" + buildNumber + "."))
+	}
+	buildPublishCmd := NewBuildPublishCommand().SetBuildConfiguration(buildConfiguration).SetConfig(config)
+	buildInfo, err := buildPublishCmd.createBuildInfo()
+	if err != nil {
+		return nil, err
+	}
+	sortBuildInfo(buildInfo)
+	return buildInfo, nil
+}
+
+// The modules of the build-info are aggregated using maps, so they are sorted to keep the output stable.
+// Artifacts and dependencies with the same name are sorted by their checksums.
+func sortBuildInfo(buildInfo *buildinfo.BuildInfo) {
+	sort.Slice(buildInfo.Modules, func(i, j int) bool {
+		return buildInfo.Modules[i].Id < buildInfo.Modules[j].Id
+	})
+	for _, module := range buildInfo.Modules {
+		artifacts := module.Artifacts
+		sort.Slice(artifacts, func(i, j int) bool {
+			if artifacts[i].Name != artifacts[j].Name {
+				return artifacts[i].Name < artifacts[j].Name
+			}
+			return getSha1(artifacts[i].Checksum) < getSha1(artifacts[j].Checksum)
+		})
+		dependencies := module.Dependencies
+		sort.Slice(dependencies, func(i, j int) bool {
+			if dependencies[i].Id != dependencies[j].Id {
+				return dependencies[i].Id < dependencies[j].Id
+			}
+			return getSha1(dependencies[i].Checksum) < getSha1(dependencies[j].Checksum)
+		})
+	}
+}
+
+// Shows the modules, artifacts, dependencies, environment variables and VCS details of the build-info as tables.
+func CreateBuildInfoTables(buildInfo *buildinfo.BuildInfo) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "Build:\t%s/%s\n", buildInfo.Name, buildInfo.Number)
+	fmt.Fprintf(writer, "Started:\t%s\n", buildInfo.Started)
+	if buildInfo.Vcs != nil && buildInfo.Vcs.Url != "" {
+		fmt.Fprintf(writer, "VCS URL:\t%s\n", buildInfo.Vcs.Url)
+	}
+	if buildInfo.Vcs != nil && buildInfo.Vcs.Revision != "" {
+		fmt.Fprintf(writer, "VCS revision:\t%s\n", buildInfo.Vcs.Revision)
+	}
+	writer.Flush()
+
+	buffer.WriteString("\nModules:\n")
+	writer = tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "MODULE\tARTIFACTS\tDEPENDENCIES")
+	for _, module := range buildInfo.Modules {
+		fmt.Fprintf(writer, "%s\t%d\t%d\n", module.Id, len(module.Artifacts), len(module.Dependencies))
+	}
+	writer.Flush()
+
+	buffer.WriteString("\nArtifacts:\n")
+	writer = tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "MODULE\tNAME\tSHA1\tMD5")
+	for _, module := range buildInfo.Modules {
+		for _, artifact := range module.Artifacts {
+			sha1, md5 := getChecksums(artifact.Checksum)
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", module.Id, artifact.Name, sha1, md5)
+		}
+	}
+	writer.Flush()
+
+	buffer.WriteString("\nDependencies:\n")
+	writer = tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "MODULE\tID\tSCOPES\tSHA1\tMD5")
+	for _, module := range buildInfo.Modules {
+		for _, dependency := range module.Dependencies {
+			sha1, md5 := getChecksums(dependency.Checksum)
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\n", module.Id, dependency.Id, strings.Join(dependency.Scopes, ","), sha1, md5)
+		}
+	}
+	writer.Flush()
+
+	buffer.WriteString("\nEnvironment:\n")
+	writer = tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "NAME\tVALUE")
+	var envNames []string
+	for name := range buildInfo.Properties {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+	for _, name := range envNames {
+		fmt.Fprintf(writer, "%s\t%s\n", name, buildInfo.Properties[name])
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
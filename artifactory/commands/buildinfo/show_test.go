@@ -0,0 +1,57 @@
+package buildinfo
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestBuildShow(t *testing.T) {
+	buildConfiguration := &utils.BuildConfiguration{BuildName: "build_show_test", BuildNumber: strconv.FormatInt(time.Now().UnixNano(), 10)}
+	showCmd := NewBuildShowCommand().SetBuildConfiguration(buildConfiguration).SetConfig(&buildinfo.Configuration{EnvInclude: "*", EnvExclude: "*password*"})
+	if err := showCmd.Run(); err == nil {
+		t.Error("Expected an error for a build without local build info.")
+	}
+
+	defer utils.RemoveBuildDir(buildConfiguration.BuildName, buildConfiguration.BuildNumber)
+	if err := utils.SaveBuildGeneralDetails(buildConfiguration.BuildName, buildConfiguration.BuildNumber); err != nil {
+		t.Fatal(err)
+	}
+	partials := []func(partial *buildinfo.Partial){
+		func(partial *buildinfo.Partial) {
+			partial.ModuleId = "b"
+			partial.Artifacts = []buildinfo.Artifact{{Name: "b2.jar", Checksum: &buildinfo.Checksum{Sha1: "2"}}, {Name: "b1.jar", Checksum: &buildinfo.Checksum{Sha1: "1"}}}
+		},
+		func(partial *buildinfo.Partial) {
+			partial.ModuleId = "a"
+			partial.Dependencies = []buildinfo.Dependency{{Id: "dep", Checksum: &buildinfo.Checksum{Sha1: "3"}}}
+		},
+		func(partial *buildinfo.Partial) {
+			partial.Env = buildinfo.Env{"buildInfo.env.PATH": "/bin", "buildInfo.env.PASSWORD": "secret"}
+		},
+	}
+	for _, partial := range partials {
+		if err := utils.SavePartialBuildInfo(buildConfiguration.BuildName, buildConfiguration.BuildNumber, partial); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := showCmd.Run(); err != nil {
+		t.Fatal(err)
+	}
+	buildInfo := showCmd.BuildInfo()
+	if len(buildInfo.Modules) != 2 || buildInfo.Modules[0].Id != "a" || buildInfo.Modules[1].Id != "b" {
+		t.Fatalf("Expected modules a and b, got %v.", buildInfo.Modules)
+	}
+	if artifacts := buildInfo.Modules[1].Artifacts; len(artifacts) != 2 || artifacts[0].Name != "b1.jar" {
+		t.Errorf("Expected the artifacts of module b sorted by name, got %v.", artifacts)
+	}
+	if len(buildInfo.Modules[0].Dependencies) != 1 {
+		t.Errorf("Expected a dependency in module a, got %v.", buildInfo.Modules[0].Dependencies)
+	}
+	if len(buildInfo.Properties) != 1 || buildInfo.Properties["buildInfo.env.PATH"] != "/bin" {
+		t.Errorf("Expected the excluded environment variables to be filtered, got %v.", buildInfo.Properties)
+	}
+}
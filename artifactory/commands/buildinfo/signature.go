@@ -0,0 +1,182 @@
+package buildinfo
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The name of the signature file of a build-info, without the extension of the signature type.
+const buildInfoSignatureName = "build-info.json"
+
+// Returns the canonical JSON of a build-info, which is signed when it is published and verified after it is fetched from Artifactory.
+// Its modules, artifacts, dependencies and issues are sorted, since Artifactory may return them in a different order.
+// The Artifactory principal is omitted, since Artifactory may set it when the build-info is published.
+func CanonicalBuildInfo(buildInfo *buildinfo.BuildInfo) ([]byte, error) {
+	// Copy the build-info, to keep the original as is.
+	content, err := json.Marshal(buildInfo)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	canonical := &buildinfo.BuildInfo{}
+	if err = json.Unmarshal(content, canonical); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	canonical.ArtifactoryPrincipal = ""
+	sortBuildInfo(canonical)
+	if canonical.Issues != nil {
+		affectedIssues := canonical.Issues.AffectedIssues
+		sort.SliceStable(affectedIssues, func(i, j int) bool {
+			return affectedIssues[i].Key < affectedIssues[j].Key
+		})
+	}
+	// The keys of maps are sorted by json.Marshal.
+	content, err = json.Marshal(canonical)
+	return content, errorutils.CheckError(err)
+}
+
+// Returns the path of the signature of a build-info in the signature repository.
+func getBuildInfoSignaturePath(signatureRepo, buildName, buildNumber, extension string) string {
+	return path.Join(signatureRepo, buildName, buildNumber, buildInfoSignatureName+extension)
+}
+
+// Signs the canonical JSON of the build-info.
+func signBuildInfo(buildInfo *buildinfo.BuildInfo, signer utils.Signer) ([]byte, error) {
+	content, err := CanonicalBuildInfo(buildInfo)
+	if err != nil {
+		return nil, err
+	}
+	return signer.Sign(content)
+}
+
+// Uploads the signature of a build-info to the signature repository.
+func uploadBuildInfoSignature(servicesManager *artifactory.ArtifactoryServicesManager, buildInfo *buildinfo.BuildInfo, signature []byte, signatureRepo, extension string) error {
+	tempDir, err := ioutil.TempDir("", "jfrog.cli.signature.")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer os.RemoveAll(tempDir)
+	localPath := filepath.Join(tempDir, buildInfoSignatureName+extension)
+	if err = ioutil.WriteFile(localPath, signature, 0600); err != nil {
+		return errorutils.CheckError(err)
+	}
+	targetPath := getBuildInfoSignaturePath(signatureRepo, buildInfo.Name, buildInfo.Number, extension)
+	uploadParams := services.NewUploadParams()
+	uploadParams.ArtifactoryCommonParams = &clientutils.ArtifactoryCommonParams{Pattern: localPath, Target: targetPath}
+	uploadParams.Flat = true
+	_, _, failCount, err := servicesManager.UploadFiles(uploadParams)
+	if err != nil {
+		return err
+	}
+	if failCount > 0 {
+		return errorutils.CheckError(errors.New("Failed uploading the build info signature to " + targetPath + "."))
+	}
+	log.Info("Uploaded the build info signature to", targetPath+".")
+	return nil
+}
+
+// Fetches a published build-info and its signature, and verifies the signature using a public key.
+type BuildVerifySignatureCommand struct {
+	rtDetails          *config.ArtifactoryDetails
+	buildConfiguration *utils.BuildConfiguration
+	signatureRepo      string
+	publicKeyPath      string
+}
+
+func NewBuildVerifySignatureCommand() *BuildVerifySignatureCommand {
+	return &BuildVerifySignatureCommand{}
+}
+
+func (bvsc *BuildVerifySignatureCommand) SetRtDetails(rtDetails *config.ArtifactoryDetails) *BuildVerifySignatureCommand {
+	bvsc.rtDetails = rtDetails
+	return bvsc
+}
+
+func (bvsc *BuildVerifySignatureCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *BuildVerifySignatureCommand {
+	bvsc.buildConfiguration = buildConfiguration
+	return bvsc
+}
+
+func (bvsc *BuildVerifySignatureCommand) SetSignatureRepo(signatureRepo string) *BuildVerifySignatureCommand {
+	bvsc.signatureRepo = signatureRepo
+	return bvsc
+}
+
+func (bvsc *BuildVerifySignatureCommand) SetPublicKeyPath(publicKeyPath string) *BuildVerifySignatureCommand {
+	bvsc.publicKeyPath = publicKeyPath
+	return bvsc
+}
+
+func (bvsc *BuildVerifySignatureCommand) CommandName() string {
+	return "rt_build_verify_signature"
+}
+
+func (bvsc *BuildVerifySignatureCommand) RtDetails() (*config.ArtifactoryDetails, error) {
+	return bvsc.rtDetails, nil
+}
+
+func (bvsc *BuildVerifySignatureCommand) Run() error {
+	buildName, buildNumber := bvsc.buildConfiguration.BuildName, bvsc.buildConfiguration.BuildNumber
+	servicesManager, err := utils.CreateServiceManager(bvsc.rtDetails, false)
+	if err != nil {
+		return err
+	}
+	buildInfo, err := servicesManager.GetBuildInfo(services.BuildInfoParams{BuildName: buildName, BuildNumber: buildNumber})
+	if err != nil {
+		return err
+	}
+	if buildInfo.Name == "" {
+		return errorutils.CheckError(errors.New("Build " + buildName + "/" + buildNumber + " was not found in Artifactory."))
+	}
+	signature, signaturePath, err := bvsc.readSignature(servicesManager)
+	if err != nil {
+		return err
+	}
+	content, err := CanonicalBuildInfo(buildInfo)
+	if err != nil {
+		return err
+	}
+	if err = utils.VerifySignature(bvsc.publicKeyPath, content, signature); err != nil {
+		return errorutils.CheckError(errors.New("Failed verifying the signature " + signaturePath + " of build " + buildName + "/" + buildNumber + ". " + err.Error()))
+	}
+	log.Info("The signature of build", buildName+"/"+buildNumber, "is valid.")
+	return nil
+}
+
+// Reads the signature of the build-info from the signature repository. The signature of each key type has its own extension.
+func (bvsc *BuildVerifySignatureCommand) readSignature(servicesManager *artifactory.ArtifactoryServicesManager) ([]byte, string, error) {
+	buildName, buildNumber := bvsc.buildConfiguration.BuildName, bvsc.buildConfiguration.BuildNumber
+	for _, extension := range []string{utils.PgpSignatureExtension, utils.Ed25519SignatureExtension} {
+		signaturePath := getBuildInfoSignaturePath(bvsc.signatureRepo, buildName, buildNumber, extension)
+		searchParams := services.NewSearchParams()
+		searchParams.ArtifactoryCommonParams = &clientutils.ArtifactoryCommonParams{Pattern: signaturePath}
+		results, err := servicesManager.SearchFiles(searchParams)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(results) == 0 {
+			continue
+		}
+		reader, err := servicesManager.ReadRemoteFile(signaturePath)
+		if err != nil {
+			return nil, "", err
+		}
+		signature, err := ioutil.ReadAll(reader)
+		reader.Close()
+		return signature, signaturePath, errorutils.CheckError(err)
+	}
+	return nil, "", errorutils.CheckError(errors.New("No signature of build " + buildName + "/" + buildNumber + " was found in the " + bvsc.signatureRepo + " repository."))
+}
@@ -0,0 +1,52 @@
+package buildinfo
+
+import (
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestCanonicalBuildInfo(t *testing.T) {
+	createBuildInfo := func(principal string, reverse bool) *buildinfo.BuildInfo {
+		modules := []buildinfo.Module{
+			{Id: "a", Artifacts: []buildinfo.Artifact{{Name: "a1", Checksum: &buildinfo.Checksum{Sha1: "1"}}, {Name: "a1", Checksum: &buildinfo.Checksum{Sha1: "2"}}}},
+			{Id: "b", Dependencies: []buildinfo.Dependency{{Id: "d1"}, {Id: "d2"}}},
+		}
+		issues := []buildinfo.AffectedIssue{{Key: "PROJ-1"}, {Key: "PROJ-2"}}
+		if reverse {
+			modules[0].Artifacts[0], modules[0].Artifacts[1] = modules[0].Artifacts[1], modules[0].Artifacts[0]
+			modules[1].Dependencies[0], modules[1].Dependencies[1] = modules[1].Dependencies[1], modules[1].Dependencies[0]
+			modules[0], modules[1] = modules[1], modules[0]
+			issues[0], issues[1] = issues[1], issues[0]
+		}
+		return &buildinfo.BuildInfo{Name: "build", Number: "1", ArtifactoryPrincipal: principal, Modules: modules,
+			Properties: buildinfo.Env{"buildInfo.env.A": "1", "buildInfo.env.B": "2"}, Issues: &buildinfo.Issues{AffectedIssues: issues}}
+	}
+	buildInfo := createBuildInfo("", false)
+	expected, err := CanonicalBuildInfo(buildInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The order of the modules, artifacts, dependencies and issues, and the Artifactory principal, do not change the canonical build-info.
+	actual, err := CanonicalBuildInfo(createBuildInfo("admin", true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(actual) != string(expected) {
+		t.Errorf("Expected the canonical build-info %s, got %s", expected, actual)
+	}
+	// The original build-info is not modified.
+	if buildInfo.Modules[0].Id != "a" || buildInfo.ArtifactoryPrincipal != "" {
+		t.Error("The original build-info was modified.")
+	}
+	buildInfo.Number = "2"
+	if actual, err = CanonicalBuildInfo(buildInfo); err != nil || string(actual) == string(expected) {
+		t.Error("Expected a different canonical build-info for a different build.")
+	}
+}
+
+func TestGetBuildInfoSignaturePath(t *testing.T) {
+	if path := getBuildInfoSignaturePath("signatures", "my/build", "1", ".asc"); path != "signatures/my/build/1/build-info.json.asc" {
+		t.Error("Unexpected signature path", path)
+	}
+}
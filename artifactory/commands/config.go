@@ -328,6 +328,9 @@ func printConfigs(configuration []*config.ArtifactoryDetails) {
 		if details.SshKeyPath != "" {
 			log.Output("SSH key file path: " + details.SshKeyPath)
 		}
+		if details.LimitRate != "" {
+			log.Output("Limit rate: " + details.LimitRate)
+		}
 		log.Output("Default: ", details.IsDefault)
 		log.Output()
 	}
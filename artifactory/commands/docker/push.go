@@ -4,13 +4,17 @@ import (
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils/docker"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 	"strings"
 )
 
 type DockerPushCommand struct {
 	DockerCommand
 	threads int
+	// Maximum transfer rate of the image layers in bytes per second. 0 means unlimited.
+	limitRate int64
 }
 
 func NewDockerPushCommand() *DockerPushCommand {
@@ -26,6 +30,15 @@ func (dpc *DockerPushCommand) SetThreads(threads int) *DockerPushCommand {
 	return dpc
 }
 
+func (dpc *DockerPushCommand) LimitRate() int64 {
+	return dpc.limitRate
+}
+
+func (dpc *DockerPushCommand) SetLimitRate(limitRate int64) *DockerPushCommand {
+	dpc.limitRate = limitRate
+	return dpc
+}
+
 // Push docker image and create build info if needed
 func (dpc *DockerPushCommand) Run() error {
 	// Perform login
@@ -46,7 +59,11 @@ func (dpc *DockerPushCommand) Run() error {
 		dpc.imageTag = dpc.imageTag + ":latest"
 	}
 	image := docker.New(dpc.imageTag)
-	err = image.Push()
+	if dpc.limitRate > 0 {
+		err = dpc.pushWithLimitRate(rtDetails)
+	} else {
+		err = image.Push()
+	}
 	if err != nil {
 		return err
 	}
@@ -73,6 +90,26 @@ func (dpc *DockerPushCommand) Run() error {
 	return utils.SaveBuildInfo(dpc.BuildConfiguration().BuildName, dpc.BuildConfiguration().BuildNumber, buildInfo)
 }
 
+// Pushes the image through a local rate limited registry, since the layers are uploaded by the docker daemon.
+// The image is tagged for the rate limited registry, and the tag is removed after the push.
+func (dpc *DockerPushCommand) pushWithLimitRate(rtDetails *config.ArtifactoryDetails) error {
+	registry, err := docker.StartRateLimitedRegistry(dpc.imageTag, rtDetails, ratelimit.NewLimiter(dpc.limitRate))
+	if err != nil {
+		return err
+	}
+	defer registry.Close()
+	proxiedTag := registry.ProxiedTag(dpc.imageTag)
+	if err = docker.TagImage(dpc.imageTag, proxiedTag); err != nil {
+		return err
+	}
+	defer func() {
+		if err := docker.RemoveImageTag(proxiedTag); err != nil {
+			log.Warn("Failed removing the temporary image tag", proxiedTag+":", err.Error())
+		}
+	}()
+	return docker.New(proxiedTag).Push()
+}
+
 func (dpc *DockerPushCommand) CommandName() string {
 	return "rt_docker_push"
 }
@@ -0,0 +1,184 @@
+package generic
+
+import (
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The files selected for deletion by a single retention rule.
+type CleanupRulePlan struct {
+	Rule  string
+	Items []clientutils.ResultItem
+}
+
+// Deletes artifacts according to a retention policy.
+type CleanupCommand struct {
+	GenericCommand
+	policyPath string
+	plan       []CleanupRulePlan
+}
+
+func NewCleanupCommand() *CleanupCommand {
+	return &CleanupCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (cc *CleanupCommand) PolicyPath() string {
+	return cc.policyPath
+}
+
+func (cc *CleanupCommand) SetPolicyPath(policyPath string) *CleanupCommand {
+	cc.policyPath = policyPath
+	return cc
+}
+
+func (cc *CleanupCommand) Plan() []CleanupRulePlan {
+	return cc.plan
+}
+
+func (cc *CleanupCommand) CommandName() string {
+	return "rt_cleanup"
+}
+
+func (cc *CleanupCommand) Run() error {
+	policy, err := ReadRetentionPolicy(cc.policyPath)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := cc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+	err = cc.createPlan(policy, servicesManager)
+	if err != nil {
+		return err
+	}
+	itemsToDelete := cc.planItems()
+	if len(itemsToDelete) == 0 {
+		log.Info("No artifacts to delete.")
+		return nil
+	}
+	log.Output(CreateCleanupPlanReport(cc.plan))
+	if cc.dryRun {
+		return nil
+	}
+	if !cc.quiet && !cliutils.InteractiveConfirm("Are you sure you want to delete the above artifacts?") {
+		return nil
+	}
+	deletedCount, err := servicesManager.DeleteFiles(itemsToDelete)
+	cc.result.SetSuccessCount(deletedCount)
+	cc.result.SetFailCount(len(itemsToDelete) - deletedCount)
+	return err
+}
+
+func (cc *CleanupCommand) createPlan(policy *RetentionPolicy, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	planned := make(map[string]bool)
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		log.Info("Evaluating retention rule", rule.Name+"...")
+		items, err := cc.evaluateRule(rule, &policy.Protect, servicesManager)
+		if err != nil {
+			return err
+		}
+		// An artifact selected by more than one rule is listed only under the first one.
+		items = excludeItems(items, planned)
+		for _, item := range items {
+			planned[item.GetItemRelativePath()] = true
+		}
+		cc.plan = append(cc.plan, CleanupRulePlan{Rule: rule.Name, Items: items})
+	}
+	return nil
+}
+
+func (cc *CleanupCommand) evaluateRule(rule *RetentionRule, protect *RetentionProtect, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.ResultItem, error) {
+	candidates, err := execRetentionAql(rule.createAql(true), servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	if rule.KeepLatest > 0 {
+		allItems := candidates
+		if rule.hasAgeConditions() {
+			// The newest artifacts are determined among all the artifacts, not only the ones meeting the age conditions.
+			allItems, err = execRetentionAql(rule.createAql(false), servicesManager)
+			if err != nil {
+				return nil, err
+			}
+		}
+		baseFolder := ""
+		if rule.VersionFolders {
+			baseFolder = rule.getBaseFolder()
+		}
+		candidates = intersectItems(candidates, selectExceedingLatest(allItems, rule.KeepLatest, baseFolder))
+	}
+	protectedPaths := make(map[string]bool)
+	for _, query := range protect.createAqls(rule) {
+		protectedItems, err := execRetentionAql(query, servicesManager)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range protectedItems {
+			protectedPaths[item.GetItemRelativePath()] = true
+		}
+	}
+	return excludeItems(candidates, protectedPaths), nil
+}
+
+func execRetentionAql(query string, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.ResultItem, error) {
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	return parseAqlResult(content)
+}
+
+func (cc *CleanupCommand) planItems() []clientutils.ResultItem {
+	var items []clientutils.ResultItem
+	for _, rulePlan := range cc.plan {
+		items = append(items, rulePlan.Items...)
+	}
+	return items
+}
+
+// Returns a report of the cleanup plan, grouped by rule and folder, including the reclaimable bytes.
+func CreateCleanupPlanReport(plan []CleanupRulePlan) string {
+	report := ""
+	var totalCount int
+	var totalSize int64
+	for _, rulePlan := range plan {
+		groups := make(map[string]*SearchGroup)
+		var ruleSize int64
+		for _, item := range rulePlan.Items {
+			folder := path.Join(item.Repo, item.Path)
+			if groups[folder] == nil {
+				groups[folder] = &SearchGroup{Key: folder}
+			}
+			groups[folder].Count++
+			groups[folder].Size += item.Size
+			ruleSize += item.Size
+		}
+		report += fmt.Sprintf("Rule '%s': %d artifacts, %d bytes\n", rulePlan.Rule, len(rulePlan.Items), ruleSize)
+		folders := make([]string, 0, len(groups))
+		for folder := range groups {
+			folders = append(folders, folder)
+		}
+		sort.Strings(folders)
+		for _, folder := range folders {
+			report += fmt.Sprintf("  %s: %d artifacts, %d bytes\n", folder, groups[folder].Count, groups[folder].Size)
+		}
+		totalCount += len(rulePlan.Items)
+		totalSize += ruleSize
+	}
+	return report + fmt.Sprintf("Total: %d artifacts, %d reclaimable bytes", totalCount, totalSize)
+}
@@ -1,20 +1,48 @@
 package generic
 
 import (
+	commandsutils "github.com/jfrog/jfrog-cli-go/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
-	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type CopyCommand struct {
 	GenericCommand
+	onConflict    string
+	verify        bool
+	discrepancies []VerificationDiscrepancy
 }
 
 func NewCopyCommand() *CopyCommand {
 	return &CopyCommand{GenericCommand: *NewGenericCommand()}
 }
 
+func (cc *CopyCommand) OnConflict() string {
+	return cc.onConflict
+}
+
+// Sets what to do when a different file already exists in the target path. See OnConflictSkip and the other policies.
+func (cc *CopyCommand) SetOnConflict(onConflict string) *CopyCommand {
+	cc.onConflict = onConflict
+	return cc
+}
+
+func (cc *CopyCommand) Verify() bool {
+	return cc.verify
+}
+
+// If set, the checksums of the target files are compared with the source files after the copy operation.
+func (cc *CopyCommand) SetVerify(verify bool) *CopyCommand {
+	cc.verify = verify
+	return cc
+}
+
+// The files whose checksums in the target path were found different from the source files by the verification.
+func (cc *CopyCommand) Discrepancies() []VerificationDiscrepancy {
+	return cc.discrepancies
+}
+
 func (cc *CopyCommand) CommandName() string {
 	return "rt_copy"
 }
@@ -27,26 +55,25 @@ func (cc *CopyCommand) Run() error {
 		return err
 	}
 
-	// Copy Loop:
-	for i := 0; i < len(cc.spec.Files); i++ {
-
-		copyParams, err := getCopyParams(cc.spec.Get(i))
-		if err != nil {
-			log.Error(err)
-			continue
-		}
+	if cc.onConflict != "" || cc.verify {
+		cc.discrepancies, err = moveCopyWithPolicy(services.COPY, cc.spec, cc.onConflict, cc.verify, cc.dryRun, servicesManager, cc.result)
+		return err
+	}
 
-		partialSuccess, partialFailed, err := servicesManager.Copy(copyParams)
-		success := cc.result.SuccessCount() + partialSuccess
-		cc.result.SetSuccessCount(success)
-		failed := cc.result.FailCount() + partialFailed
-		cc.result.SetFailCount(failed)
+	// Copy the file groups concurrently. A failure in one group does not stop the other groups.
+	groupResults := make([]commandsutils.Result, len(cc.spec.Files))
+	groupErrors := runSpecFileGroups(cc.spec, cc.threads, func(index int, file *spec.File) error {
+		copyParams, err := getCopyParams(file)
 		if err != nil {
-			log.Error(err)
-			continue
+			return err
 		}
-	}
-	return err
+		success, failed, err := servicesManager.Copy(copyParams)
+		groupResults[index].SetSuccessCount(success)
+		groupResults[index].SetFailCount(failed)
+		return err
+	})
+	addGroupResults(cc.result, groupResults)
+	return combineSpecFileGroupErrors(groupErrors)
 }
 
 func getCopyParams(f *spec.File) (copyParams services.MoveCopyParams, err error) {
@@ -5,15 +5,19 @@ import (
 	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type DeleteCommand struct {
 	deleteItems []clientutils.ResultItem
 	GenericCommand
-	quiet bool
+	quiet          bool
+	quarantineRepo string
+	deletionId     string
 }
 
 func NewDeleteCommand() *DeleteCommand {
@@ -29,6 +33,21 @@ func (dc *DeleteCommand) SetQuiet(quiet bool) *DeleteCommand {
 	return dc
 }
 
+func (dc *DeleteCommand) QuarantineRepo() string {
+	return dc.quarantineRepo
+}
+
+// If set, the deleted items are moved to the quarantine repository, from which they can be restored.
+func (dc *DeleteCommand) SetQuarantineRepo(quarantineRepo string) *DeleteCommand {
+	dc.quarantineRepo = quarantineRepo
+	return dc
+}
+
+// The ID of the quarantine deletion, which can be used to restore the deleted items.
+func (dc *DeleteCommand) DeletionId() string {
+	return dc.deletionId
+}
+
 func (dc *DeleteCommand) DeleteItems() []clientutils.ResultItem {
 	return dc.deleteItems
 }
@@ -66,16 +85,19 @@ func (dc *DeleteCommand) GetPathsToDelete() error {
 	if err != nil {
 		return err
 	}
-	for i := 0; i < len(dc.Spec().Files); i++ {
-		deleteParams, err := getDeleteParams(dc.Spec().Get(i))
-		if err != nil {
-			return err
-		}
-
-		currentResultItems, err := servicesManager.GetPathsToDelete(deleteParams)
+	groupResultItems := make([][]clientutils.ResultItem, len(dc.Spec().Files))
+	groupErrors := runSpecFileGroups(dc.Spec(), dc.threads, func(index int, file *spec.File) error {
+		deleteParams, err := getDeleteParams(file)
 		if err != nil {
 			return err
 		}
+		groupResultItems[index], err = servicesManager.GetPathsToDelete(deleteParams)
+		return err
+	})
+	if err = combineSpecFileGroupErrors(groupErrors); err != nil {
+		return err
+	}
+	for _, currentResultItems := range groupResultItems {
 		dc.deleteItems = append(dc.deleteItems, currentResultItems...)
 	}
 	return nil
@@ -90,10 +112,22 @@ func (dc *DeleteCommand) DeleteFiles() (successCount, failedCount int, err error
 	if err != nil {
 		return 0, 0, err
 	}
+	if dc.quarantineRepo != "" {
+		return dc.quarantineFiles(servicesManager)
+	}
 	deletedCount, err := servicesManager.DeleteFiles(dc.deleteItems)
 	return deletedCount, len(dc.deleteItems) - deletedCount, err
 }
 
+func (dc *DeleteCommand) quarantineFiles(servicesManager *artifactory.ArtifactoryServicesManager) (successCount, failedCount int, err error) {
+	dc.deletionId, successCount, err = quarantineItems(dc.deleteItems, dc.quarantineRepo, dc.DryRun(), servicesManager)
+	if successCount > 0 {
+		log.Info(fmt.Sprintf("Moved %d items to the '%s' quarantine repository with deletion ID %s. Run 'jfrog rt restore --deletion-id=%s' to restore them.",
+			successCount, dc.quarantineRepo, dc.deletionId, dc.deletionId))
+	}
+	return successCount, len(dc.deleteItems) - successCount, err
+}
+
 func getDeleteParams(f *spec.File) (deleteParams services.DeleteParams, err error) {
 	deleteParams = services.NewDeleteParams()
 	deleteParams.ArtifactoryCommonParams = f.ToArtifactoryCommonParams()
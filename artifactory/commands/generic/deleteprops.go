@@ -31,7 +31,10 @@ func (deleteProps *DeletePropsCommand) Run() error {
 		return err
 	}
 
-	resultItems := searchItems(deleteProps.Spec(), servicesManager)
+	resultItems, err := searchItems(deleteProps.Spec(), deleteProps.threads, servicesManager)
+	if err != nil {
+		return err
+	}
 
 	propsParams := GetPropsParams(resultItems, deleteProps.props)
 	success, err := servicesManager.DeleteProps(propsParams)
@@ -0,0 +1,179 @@
+package generic
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	DiffLocalOnly  = "local-only"
+	DiffRemoteOnly = "remote-only"
+	DiffModified   = "modified"
+)
+
+type DiffResult struct {
+	Path       string `json:"path,omitempty"`
+	Status     string `json:"status,omitempty"`
+	LocalSha1  string `json:"localSha1,omitempty"`
+	RemoteSha1 string `json:"remoteSha1,omitempty"`
+}
+
+type DiffCommand struct {
+	GenericCommand
+	localPath  string
+	repoPath   string
+	recursive  bool
+	diffResult []DiffResult
+}
+
+func NewDiffCommand() *DiffCommand {
+	return &DiffCommand{GenericCommand: *NewGenericCommand(), recursive: true}
+}
+
+func (dc *DiffCommand) LocalPath() string {
+	return dc.localPath
+}
+
+func (dc *DiffCommand) SetLocalPath(localPath string) *DiffCommand {
+	dc.localPath = localPath
+	return dc
+}
+
+func (dc *DiffCommand) RepoPath() string {
+	return dc.repoPath
+}
+
+func (dc *DiffCommand) SetRepoPath(repoPath string) *DiffCommand {
+	dc.repoPath = repoPath
+	return dc
+}
+
+func (dc *DiffCommand) Recursive() bool {
+	return dc.recursive
+}
+
+func (dc *DiffCommand) SetRecursive(recursive bool) *DiffCommand {
+	dc.recursive = recursive
+	return dc
+}
+
+func (dc *DiffCommand) DiffResult() []DiffResult {
+	return dc.diffResult
+}
+
+func (dc *DiffCommand) CommandName() string {
+	return "rt_diff"
+}
+
+func (dc *DiffCommand) Run() error {
+	return dc.Diff()
+}
+
+func (dc *DiffCommand) Diff() error {
+	localChecksums, err := dc.getLocalChecksums()
+	if err != nil {
+		return err
+	}
+	remoteChecksums, err := dc.getRemoteChecksums()
+	if err != nil {
+		return err
+	}
+	dc.diffResult = compareChecksums(localChecksums, remoteChecksums)
+	log.Info("Found", len(dc.diffResult), "differences.")
+	return nil
+}
+
+// Returns the SHA1 checksums of the local files, mapped by their path relative to the local path.
+func (dc *DiffCommand) getLocalChecksums() (map[string]string, error) {
+	localChecksums, err := getLocalChecksums(dc.localPath, dc.recursive)
+	if err != nil {
+		return nil, err
+	}
+	checksums := make(map[string]string, len(localChecksums))
+	for path, details := range localChecksums {
+		checksums[path] = details.Sha1
+	}
+	return checksums, nil
+}
+
+// Returns the SHA1 checksums of the artifacts, mapped by their path relative to the repository path.
+func (dc *DiffCommand) getRemoteChecksums() (map[string]string, error) {
+	log.Info("Searching artifacts...")
+	rtDetails, err := dc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return nil, err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return nil, err
+	}
+	repoPath := strings.TrimSuffix(dc.repoPath, "/")
+	searchSpec := spec.NewBuilder().
+		Pattern(repoPath + "/*").
+		Recursive(dc.recursive).
+		BuildSpec()
+	searchParams, err := GetSearchParams(searchSpec.Get(0))
+	if err != nil {
+		return nil, err
+	}
+	resultItems, err := servicesManager.SearchFiles(searchParams)
+	if err != nil {
+		return nil, err
+	}
+	return mapChecksumsByRelativePath(resultItems, repoPath), nil
+}
+
+func mapChecksumsByRelativePath(resultItems []clientutils.ResultItem, repoPath string) map[string]string {
+	checksums := make(map[string]string, len(resultItems))
+	for _, item := range resultItems {
+		if item.Type == "folder" {
+			continue
+		}
+		relativePath := strings.TrimPrefix(item.GetItemRelativePath(), repoPath+"/")
+		checksums[relativePath] = item.Actual_Sha1
+	}
+	return checksums
+}
+
+// Compares the local and remote checksums, and returns the differences sorted by path.
+func compareChecksums(localChecksums, remoteChecksums map[string]string) []DiffResult {
+	result := []DiffResult{}
+	for path, localSha1 := range localChecksums {
+		remoteSha1, exists := remoteChecksums[path]
+		if !exists {
+			result = append(result, DiffResult{Path: path, Status: DiffLocalOnly, LocalSha1: localSha1})
+		} else if remoteSha1 != localSha1 {
+			result = append(result, DiffResult{Path: path, Status: DiffModified, LocalSha1: localSha1, RemoteSha1: remoteSha1})
+		}
+	}
+	for path, remoteSha1 := range remoteChecksums {
+		if _, exists := localChecksums[path]; !exists {
+			result = append(result, DiffResult{Path: path, Status: DiffRemoteOnly, RemoteSha1: remoteSha1})
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+	return result
+}
+
+// Shows the diff results as a table of the local and remote checksums of each file.
+func CreateDiffTable(diffResult []DiffResult) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "STATUS\tPATH\tLOCAL SHA1\tREMOTE SHA1")
+	for _, result := range diffResult {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", result.Status, result.Path, result.LocalSha1, result.RemoteSha1)
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
@@ -0,0 +1,51 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+func TestCompareChecksums(t *testing.T) {
+	local := map[string]string{"a.txt": "1", "b/c.txt": "2", "d.txt": "3"}
+	remote := map[string]string{"a.txt": "1", "b/c.txt": "4", "e.txt": "5"}
+	expected := []DiffResult{
+		{Path: "b/c.txt", Status: DiffModified, LocalSha1: "2", RemoteSha1: "4"},
+		{Path: "d.txt", Status: DiffLocalOnly, LocalSha1: "3"},
+		{Path: "e.txt", Status: DiffRemoteOnly, RemoteSha1: "5"},
+	}
+	actual := compareChecksums(local, remote)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+	if len(compareChecksums(local, local)) != 0 {
+		t.Error("Expected no differences when comparing identical checksums.")
+	}
+}
+
+func TestMapChecksumsByRelativePath(t *testing.T) {
+	resultItems := []clientutils.ResultItem{
+		{Repo: "repo", Path: "dir", Name: "a.txt", Actual_Sha1: "1", Type: "file"},
+		{Repo: "repo", Path: "dir/sub", Name: "b.txt", Actual_Sha1: "2", Type: "file"},
+		{Repo: "repo", Path: "dir", Name: "sub", Type: "folder"},
+	}
+	expected := map[string]string{"a.txt": "1", "sub/b.txt": "2"}
+	actual := mapChecksumsByRelativePath(resultItems, "repo/dir")
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestCreateDiffTable(t *testing.T) {
+	diffResult := []DiffResult{
+		{Path: "a.txt", Status: DiffModified, LocalSha1: "1", RemoteSha1: "2"},
+		{Path: "b/c.txt", Status: DiffLocalOnly, LocalSha1: "3", RemoteSha1: "-"},
+	}
+	expected := "STATUS      PATH     LOCAL SHA1  REMOTE SHA1\n" +
+		"modified    a.txt    1           2\n" +
+		"local-only  b/c.txt  3           -"
+	if actual := CreateDiffTable(diffResult); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
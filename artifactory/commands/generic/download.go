@@ -5,7 +5,9 @@ import (
 	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
 	"github.com/jfrog/jfrog-cli-go/utils/progressbar"
+	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
@@ -93,12 +95,26 @@ func (dc *DownloadCommand) Run() error {
 		}
 		downloadParamsArray = append(downloadParamsArray, downParams)
 	}
+	var downloadCache *downloadcache.DownloadCache
+	var restoredFiles []clientutils.FileInfo
+	if dc.configuration.UseCache && !dc.DryRun() {
+		downloadCache, err = downloadcache.GetDownloadCache()
+		if err != nil {
+			return err
+		}
+		downloadParamsArray, restoredFiles = restoreFromCache(downloadCache, servicesManager, downloadParamsArray)
+	}
 	// Perform download.
 	filesInfo, totalExpected, err := servicesManager.DownloadFiles(downloadParamsArray...)
 	if err != nil {
 		errorOccurred = true
 		log.Error(err)
 	}
+	if downloadCache != nil {
+		addToCache(downloadCache, filesInfo)
+		filesInfo = append(filesInfo, restoredFiles...)
+		totalExpected += len(restoredFiles)
+	}
 
 	dc.result.SetSuccessCount(len(filesInfo))
 	dc.result.SetFailCount(totalExpected - len(filesInfo))
@@ -132,6 +148,99 @@ func (dc *DownloadCommand) Run() error {
 	return err
 }
 
+// Places files from the download cache in the local paths into which they are about to be downloaded.
+// Returns the groups which still need to be downloaded, and the files of the groups which don't.
+// Each group is searched once. A group whose files were all restored from the cache, or already exist locally with the
+// expected checksums, is not downloaded, and therefore not searched again. The other groups are downloaded as usual,
+// and the files restored from the cache are skipped by the download, since they already exist with the expected checksums.
+// The cache is used on a best-effort basis, so errors are logged and do not fail the download.
+func restoreFromCache(downloadCache *downloadcache.DownloadCache, servicesManager *artifactory.ArtifactoryServicesManager, downloadParamsArray []services.DownloadParams) ([]services.DownloadParams, []clientutils.FileInfo) {
+	var remainingParams []services.DownloadParams
+	var restoredFiles []clientutils.FileInfo
+	restored := 0
+	for _, downParams := range downloadParamsArray {
+		// Exploded archives, symlinks and directories are handled by the download.
+		if downParams.IsExplode() || downParams.IsSymlink() || downParams.IncludeDirs {
+			remainingParams = append(remainingParams, downParams)
+			continue
+		}
+		groupFiles, groupRestored, complete := restoreGroupFromCache(downloadCache, servicesManager, downParams)
+		restored += groupRestored
+		if !complete {
+			remainingParams = append(remainingParams, downParams)
+			continue
+		}
+		restoredFiles = append(restoredFiles, groupFiles...)
+	}
+	if restored > 0 {
+		log.Info("Restored", restored, "files from the download cache.")
+	}
+	return remainingParams, restoredFiles
+}
+
+// Restores the files of a single group from the download cache. Returns the files of the group, the number of files
+// restored from the cache, and true if all the files of the group exist locally with the expected checksums.
+func restoreGroupFromCache(downloadCache *downloadcache.DownloadCache, servicesManager *artifactory.ArtifactoryServicesManager, downParams services.DownloadParams) ([]clientutils.FileInfo, int, bool) {
+	searchParams := services.NewSearchParams()
+	searchParams.ArtifactoryCommonParams = downParams.ArtifactoryCommonParams
+	resultItems, err := servicesManager.SearchFiles(searchParams)
+	if err != nil {
+		log.Warn(err)
+		return nil, 0, false
+	}
+	file := &spec.File{Pattern: downParams.Pattern, Target: downParams.Target}
+	var files []clientutils.FileInfo
+	restored := 0
+	complete := true
+	for _, item := range resultItems {
+		if item.Type == "folder" {
+			continue
+		}
+		localPath, err := getDownloadLocalPath(file, downParams.IsFlat(), item)
+		if err != nil {
+			log.Warn(err)
+			complete = false
+			continue
+		}
+		exists, found := false, false
+		if exists, err = fileutils.IsFileExists(localPath, false); exists {
+			details, err := fileutils.GetFileDetails(localPath)
+			found = err == nil && details.Checksum.Sha1 == item.Actual_Sha1
+		} else if err == nil {
+			found, err = downloadCache.Get(item.Actual_Sha1, localPath)
+			if err != nil {
+				log.Warn("Failed reading", item.GetItemRelativePath(), "from the download cache:", err.Error())
+			}
+			if found {
+				log.Debug("Restored", item.GetItemRelativePath(), "from the download cache.")
+				restored++
+			}
+		}
+		if !found {
+			complete = false
+			continue
+		}
+		files = append(files, clientutils.FileInfo{
+			ArtifactoryPath: item.GetItemRelativePath(),
+			LocalPath:       localPath,
+			FileHashes:      &clientutils.FileHashes{Sha1: item.Actual_Sha1, Md5: item.Actual_Md5},
+		})
+	}
+	return files, restored, complete
+}
+
+func addToCache(downloadCache *downloadcache.DownloadCache, filesInfo []clientutils.FileInfo) {
+	for _, fileInfo := range filesInfo {
+		// Exploded archives are removed after the download, so they cannot be added to the cache.
+		if exists, _ := fileutils.IsFileExists(fileInfo.LocalPath, false); !exists {
+			continue
+		}
+		if err := downloadCache.Put(fileInfo.Sha1, fileInfo.LocalPath); err != nil {
+			log.Warn("Failed adding", fileInfo.LocalPath, "to the download cache:", err.Error())
+		}
+	}
+}
+
 func convertFileInfoToBuildDependencies(filesInfo []clientutils.FileInfo) []buildinfo.Dependency {
 	buildDependencies := make([]buildinfo.Dependency, len(filesInfo))
 	for i, fileInfo := range filesInfo {
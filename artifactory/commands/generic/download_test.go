@@ -0,0 +1,78 @@
+package generic
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+)
+
+func TestRestoreFromCache(t *testing.T) {
+	log.SetDefaultLogger()
+	tempDir, err := ioutil.TempDir("", "download")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	downloadCache := downloadcache.New(filepath.Join(tempDir, "cache"), downloadcache.DefaultMaxSize)
+	cachedPath := filepath.Join(tempDir, "cached.txt")
+	if err = ioutil.WriteFile(cachedPath, []byte("cached"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	cachedHash := sha1.Sum([]byte("cached"))
+	cachedSha1 := hex.EncodeToString(cachedHash[:])
+	if err = downloadCache.Put(cachedSha1, cachedPath); err != nil {
+		t.Fatal(err)
+	}
+
+	searches := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		// The 'cached' group has a single file in the cache, while the 'missing' group also has a file which is not in the cache.
+		results := fmt.Sprintf(`{"repo":"repo","path":"cached","name":"a.txt","type":"file","actual_sha1":"%s"}`, cachedSha1)
+		if searches == 2 {
+			results += `,{"repo":"repo","path":"missing","name":"b.txt","type":"file","actual_sha1":"0000000000000000000000000000000000000000"}`
+		}
+		fmt.Fprintf(w, `{"results":[%s]}`, results)
+	}))
+	defer ts.Close()
+	servicesManager, err := utils.CreateServiceManager(&config.ArtifactoryDetails{Url: ts.URL + "/"}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var downloadParamsArray []services.DownloadParams
+	for _, pattern := range []string{"repo/cached/*", "repo/*"} {
+		downParams, err := getDownloadParams(&spec.File{Pattern: pattern, Target: tempDir + "/out/", Flat: "true"}, &utils.DownloadConfiguration{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		downloadParamsArray = append(downloadParamsArray, downParams)
+	}
+	remainingParams, restoredFiles := restoreFromCache(downloadCache, servicesManager, downloadParamsArray)
+	if searches != 2 {
+		t.Errorf("Expected each group to be searched once, got %d searches.", searches)
+	}
+	if len(remainingParams) != 1 || remainingParams[0].Pattern != "repo/*" {
+		t.Errorf("Expected only the group with the missing file to be downloaded, got %v.", remainingParams)
+	}
+	localPath := filepath.Join(tempDir, "out", "a.txt")
+	if len(restoredFiles) != 1 || restoredFiles[0].LocalPath != localPath || restoredFiles[0].Sha1 != cachedSha1 {
+		t.Errorf("Expected the file of the restored group, got %v.", restoredFiles)
+	}
+	if content, err := ioutil.ReadFile(localPath); err != nil || string(content) != "cached" {
+		t.Errorf("Expected the file to be restored from the cache, got %s (%v).", content, err)
+	}
+}
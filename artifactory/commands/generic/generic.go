@@ -13,6 +13,7 @@ type GenericCommand struct {
 	dryRun          bool
 	syncDeletesPath string
 	quiet           bool
+	threads         int
 }
 
 func NewGenericCommand() *GenericCommand {
@@ -46,6 +47,16 @@ func (gc *GenericCommand) SetQuiet(quiet bool) *GenericCommand {
 	return gc
 }
 
+func (gc *GenericCommand) Threads() int {
+	return gc.threads
+}
+
+// Sets the number of spec file groups which are handled concurrently.
+func (gc *GenericCommand) SetThreads(threads int) *GenericCommand {
+	gc.threads = threads
+	return gc
+}
+
 func (gc *GenericCommand) Result() *commandsutils.Result {
 	return gc.result
 }
@@ -0,0 +1,130 @@
+package generic
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/denormal/go-gitignore"
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/fspatterns"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+// The names of the ignore files, which are applied to uploads of spec file groups with respectIgnoreFiles set.
+// The ignore files use the .gitignore syntax, and may be placed in any directory.
+// The .jfrogignore files take precedence, so that they can also re-include paths ignored by .gitignore files.
+var ignoreFileNames = []string{".jfrogignore", ".gitignore"}
+
+// Special characters of regular expressions, which are not escaped by the conversion of wildcard exclusions to regular expressions.
+var unescapedSpecialChars = []string{"(", ")", "[", "]", "{", "}", "?", "|"}
+
+// Returns the local paths which are matched by the ignore files, under the root path of the upload pattern.
+// Ignored directories are returned with a trailing separator, and the paths under them are not returned.
+func getIgnoredPaths(file *spec.File, isSymlink bool) ([]string, error) {
+	isRegexp, err := file.IsRegexp(false)
+	if err != nil {
+		return nil, err
+	}
+	isRecursive, err := file.IsRecursive(true)
+	if err != nil {
+		return nil, err
+	}
+	rootPath, err := fspatterns.GetRootPath(clientutils.ReplaceTildeWithUserHome(file.Pattern), isRegexp, isSymlink)
+	if err != nil {
+		return nil, err
+	}
+	isDir, err := fileutils.IsDirExists(rootPath, false)
+	if err != nil || !isDir {
+		return nil, err
+	}
+	ignoreFiles, err := loadIgnoreFiles(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var ignoredPaths []string
+	err = filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == rootPath {
+			return nil
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return err
+		}
+		if isIgnored(ignoreFiles, absPath, info.IsDir()) {
+			if info.IsDir() {
+				ignoredPaths = append(ignoredPaths, path+string(os.PathSeparator))
+				return filepath.SkipDir
+			}
+			ignoredPaths = append(ignoredPaths, path)
+			return nil
+		}
+		if info.IsDir() && !isRecursive {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return ignoredPaths, errorutils.CheckError(err)
+}
+
+// Loads the ignore files of the current directory and its sub-directories, or of the root path if it is outside the current directory.
+// This way, the ignore files of a project apply when uploading one of its sub-directories.
+func loadIgnoreFiles(rootPath string) ([]gitignore.GitIgnore, error) {
+	base, err := filepath.Abs(rootPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if relPath, err := filepath.Rel(wd, base); err == nil && !strings.HasPrefix(relPath, "..") {
+		base = wd
+	}
+	var ignoreFiles []gitignore.GitIgnore
+	for _, name := range ignoreFileNames {
+		ignoreFile, err := gitignore.NewRepositoryWithFile(base, name)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		ignoreFiles = append(ignoreFiles, ignoreFile)
+	}
+	return ignoreFiles, nil
+}
+
+func isIgnored(ignoreFiles []gitignore.GitIgnore, absPath string, isDir bool) bool {
+	for _, ignoreFile := range ignoreFiles {
+		if match := ignoreFile.Absolute(absPath, isDir); match != nil {
+			return match.Ignore()
+		}
+	}
+	return false
+}
+
+// Returns the exclusion patterns which exclude the ignored path, and all the paths under it if it is a directory.
+func createIgnoredPathExclusions(path string, isRegexp bool) []string {
+	isDir := strings.HasSuffix(path, string(os.PathSeparator))
+	path = strings.TrimSuffix(path, string(os.PathSeparator))
+	if isRegexp {
+		exclusion := "^" + regexp.QuoteMeta(path)
+		if isDir {
+			return []string{exclusion + "(" + regexp.QuoteMeta(string(os.PathSeparator)) + ".*)?$"}
+		}
+		return []string{exclusion + "$"}
+	}
+	for _, char := range unescapedSpecialChars {
+		path = strings.Replace(path, char, "\\"+char, -1)
+	}
+	if isDir {
+		// A pattern which ends with a separator matches all the paths under the directory, but not the directory itself.
+		return []string{path, path + string(os.PathSeparator)}
+	}
+	return []string{path}
+}
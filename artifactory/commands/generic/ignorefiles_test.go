@@ -0,0 +1,91 @@
+package generic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+)
+
+func TestGetIgnoredPaths(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "ignore-files")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	files := map[string]string{
+		".gitignore":                   "node_modules/\n*.log\n",
+		"app.js":                       "",
+		"debug.log":                    "",
+		".DS_Store":                    "",
+		"node_modules/dep/index.js":    "",
+		"lib/.jfrogignore":             ".DS_Store\ncache/\n!keep.log\n",
+		"lib/.DS_Store":                "",
+		"lib/keep.log":                 "",
+		"lib/cache/entry":              "",
+		"lib/lib.js":                   "",
+		"lib/nested/node_modules/a.js": "",
+		"lib/nested/nested.js":         "",
+	}
+	for path, content := range files {
+		path = filepath.Join(tempDir, path)
+		if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sep := string(os.PathSeparator)
+	tests := []struct {
+		recursive bool
+		expected  []string
+	}{
+		{true, []string{"debug.log", "lib" + sep + ".DS_Store", "lib" + sep + "cache" + sep, "lib" + sep + "nested" + sep + "node_modules" + sep, "node_modules" + sep}},
+		{false, []string{"debug.log", "node_modules" + sep}},
+	}
+	for _, test := range tests {
+		file := spec.NewBuilder().Pattern(tempDir + "/*").Recursive(test.recursive).BuildSpec().Get(0)
+		ignoredPaths, err := getIgnoredPaths(file, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var expected []string
+		for _, path := range test.expected {
+			expected = append(expected, filepath.Join(tempDir, path))
+			if path[len(path)-1:] == sep {
+				expected[len(expected)-1] += sep
+			}
+		}
+		if !reflect.DeepEqual(expected, ignoredPaths) {
+			t.Errorf("Recursive %t: expected %v, got %v.", test.recursive, expected, ignoredPaths)
+		}
+	}
+}
+
+func TestCreateIgnoredPathExclusions(t *testing.T) {
+	sep := string(os.PathSeparator)
+	dirPath := "a(1)" + sep + "node_modules" + sep
+	if actual := createIgnoredPathExclusions(dirPath, false); !reflect.DeepEqual([]string{`a\(1\)` + sep + "node_modules", `a\(1\)` + sep + "node_modules" + sep}, actual) {
+		t.Errorf("Unexpected wildcard exclusions: %v", actual)
+	}
+	exclusions := createIgnoredPathExclusions(dirPath, true)
+	if len(exclusions) != 1 {
+		t.Fatalf("Expected a single regular expression exclusion, got %v.", exclusions)
+	}
+	exclusion := regexp.MustCompile(exclusions[0])
+	for path, expected := range map[string]bool{
+		"a(1)" + sep + "node_modules":                         true,
+		"a(1)" + sep + "node_modules" + sep + "x" + sep + "y": true,
+		"a(1)" + sep + "node_modules2":                        false,
+		"a1" + sep + "node_modules":                           false,
+	} {
+		if actual := exclusion.MatchString(path); actual != expected {
+			t.Errorf("Path %s: expected the exclusion match to be %t.", path, expected)
+		}
+	}
+}
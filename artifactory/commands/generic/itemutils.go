@@ -0,0 +1,129 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+// Moves or copies a single file or folder to the exact target path, using the Artifactory move and copy REST APIs.
+func moveCopyItem(moveType services.MoveType, sourcePath, targetPath string, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	artDetails := servicesManager.GetConfig().GetArtDetails()
+	moveUrl, err := serviceutils.BuildArtifactoryUrl(artDetails.GetUrl(), path.Join("api", string(moveType), sourcePath), map[string]string{"to": "/" + targetPath})
+	if err != nil {
+		return err
+	}
+	httpClientDetails := artDetails.CreateHttpClientDetails()
+	resp, body, err := servicesManager.Client().SendPost(moveUrl, nil, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errorutils.CheckError(errors.New("Failed to " + string(moveType) + " " + sourcePath + " to " + targetPath + ". Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+	}
+	return nil
+}
+
+// Deletes a single file or folder. A path which does not exist is not considered an error.
+func deleteItem(itemPath string, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	artDetails := servicesManager.GetConfig().GetArtDetails()
+	deleteUrl, err := serviceutils.BuildArtifactoryUrl(artDetails.GetUrl(), itemPath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	httpClientDetails := artDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().Send(http.MethodDelete, deleteUrl, nil, true, true, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return errorutils.CheckError(errors.New("Failed to delete " + itemPath + ". Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+	}
+	return nil
+}
+
+// Sets properties on a single item. Unlike the set-props command, the properties of the items under a folder are not changed.
+func setItemProps(itemPath, encodedProps string, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	return sendItemPropsRequest(http.MethodPut, itemPath, encodedProps, servicesManager)
+}
+
+// Deletes properties from a single item, without changing the properties of the items under a folder.
+func deleteItemProps(itemPath string, keys []string, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	encodedKeys := make([]string, len(keys))
+	for i, key := range keys {
+		encodedKeys[i] = url.QueryEscape(key)
+	}
+	return sendItemPropsRequest(http.MethodDelete, itemPath, strings.Join(encodedKeys, ","), servicesManager)
+}
+
+func sendItemPropsRequest(method, itemPath, encodedProps string, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	artDetails := servicesManager.GetConfig().GetArtDetails()
+	propsUrl, err := serviceutils.BuildArtifactoryUrl(artDetails.GetUrl(), path.Join("api/storage", itemPath), map[string]string{"recursive": "0"})
+	if err != nil {
+		return err
+	}
+	// The properties are already encoded, and should not be encoded again.
+	propsUrl += "&properties=" + encodedProps
+	httpClientDetails := artDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().Send(method, propsUrl, nil, true, true, &httpClientDetails)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return errorutils.CheckError(errors.New("Failed updating the properties of " + itemPath + ". Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+	}
+	return nil
+}
+
+// Returns true if a file or a folder exists in the specified path.
+func itemExists(itemPath string, servicesManager *artifactory.ArtifactoryServicesManager) (bool, error) {
+	_, exists, err := getItemSha1(itemPath, servicesManager)
+	return exists, err
+}
+
+// Returns the SHA1 checksum of the file in the specified path, and whether the path exists.
+// The checksum of a folder is empty.
+func getItemSha1(itemPath string, servicesManager *artifactory.ArtifactoryServicesManager) (sha1 string, exists bool, err error) {
+	artDetails := servicesManager.GetConfig().GetArtDetails()
+	storageUrl, err := serviceutils.BuildArtifactoryUrl(artDetails.GetUrl(), path.Join("api/storage", itemPath), make(map[string]string))
+	if err != nil {
+		return "", false, err
+	}
+	httpClientDetails := artDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(storageUrl, true, &httpClientDetails)
+	if err != nil {
+		return "", false, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		itemInfo := &struct {
+			Checksums fileutils.ChecksumDetails `json:"checksums,omitempty"`
+		}{}
+		if err = json.Unmarshal(body, itemInfo); err != nil {
+			return "", true, errorutils.CheckError(err)
+		}
+		return itemInfo.Checksums.Sha1, true, nil
+	case http.StatusNotFound:
+		return "", false, nil
+	}
+	return "", false, errorutils.CheckError(errors.New("Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+}
+
+func getItemProp(item serviceutils.ResultItem, key string) string {
+	for _, prop := range item.Properties {
+		if prop.Key == key {
+			return prop.Value
+		}
+	}
+	return ""
+}
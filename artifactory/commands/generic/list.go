@@ -0,0 +1,237 @@
+package generic
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type ListItem struct {
+	// The path of the item, relative to the listed path.
+	Path     string `json:"path,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Size     int64  `json:"size"`
+	Modified string `json:"modified,omitempty"`
+}
+
+// Lists the files and folders under a path in Artifactory, like the ls command.
+type ListCommand struct {
+	GenericCommand
+	repoPath  string
+	recursive bool
+	items     []ListItem
+}
+
+func NewListCommand() *ListCommand {
+	return &ListCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (lc *ListCommand) RepoPath() string {
+	return lc.repoPath
+}
+
+func (lc *ListCommand) SetRepoPath(repoPath string) *ListCommand {
+	lc.repoPath = repoPath
+	return lc
+}
+
+func (lc *ListCommand) Recursive() bool {
+	return lc.recursive
+}
+
+func (lc *ListCommand) SetRecursive(recursive bool) *ListCommand {
+	lc.recursive = recursive
+	return lc
+}
+
+// Returns the listed items, sorted by path.
+func (lc *ListCommand) Items() []ListItem {
+	return lc.items
+}
+
+func (lc *ListCommand) CommandName() string {
+	return "rt_ls"
+}
+
+func (lc *ListCommand) Run() error {
+	return lc.List()
+}
+
+func (lc *ListCommand) List() error {
+	rtDetails, err := lc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+	repoPath := strings.Trim(lc.repoPath, "/")
+	resultItems, err := searchListItems(createRepoPathAqlCriteria(repoPath, "any", lc.recursive), servicesManager)
+	if err != nil {
+		return err
+	}
+	if len(resultItems) == 0 {
+		// The path is a file or an empty folder, or it does not exist.
+		resultItems, err = getListedItem(repoPath, servicesManager)
+		if err != nil {
+			return err
+		}
+	}
+	lc.items = createListItems(resultItems, repoPath)
+	return nil
+}
+
+// Returns the file in the specified path. If the path is a folder, no items are returned. If it does not exist, an error is returned.
+func getListedItem(repoPath string, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.ResultItem, error) {
+	repo, dir, name := splitRepoPath(repoPath)
+	if name == "." {
+		// The root folder of the repository exists, if it has no items.
+		return nil, nil
+	}
+	criteria := []string{`{"repo":` + quoteAql(repo) + `}`, `{"path":` + quoteAql(dir) + `}`, `{"name":` + quoteAql(name) + `}`, `{"type":"any"}`}
+	resultItems, err := searchListItems(criteria, servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	if len(resultItems) == 0 {
+		return nil, errorutils.CheckError(errors.New("The path " + repoPath + " does not exist."))
+	}
+	if resultItems[0].Type == "folder" {
+		return nil, nil
+	}
+	return resultItems, nil
+}
+
+func searchListItems(criteria []string, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.ResultItem, error) {
+	query := createItemsAql(criteria, []string{"repo", "path", "name", "type", "size", "modified"})
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	return parseAqlResult(content)
+}
+
+// Splits a <repository name>/<repository path> path to the repository, the parent folder and the name of the item.
+// The parent folder of an item in the root of the repository is ".", like in AQL.
+func splitRepoPath(repoPath string) (repo, dir, name string) {
+	repo, itemPath := repoPath, "."
+	if i := strings.Index(repoPath, "/"); i >= 0 {
+		repo, itemPath = repoPath[:i], repoPath[i+1:]
+	}
+	dir, name = path.Split(itemPath)
+	if dir = strings.TrimSuffix(dir, "/"); dir == "" {
+		dir = "."
+	}
+	return
+}
+
+// Converts the AQL result items to list items, with paths relative to the listed path, sorted by path.
+// A listed file is returned with its name.
+func createListItems(resultItems []clientutils.ResultItem, repoPath string) []ListItem {
+	items := []ListItem{}
+	for _, item := range resultItems {
+		itemPath := path.Join(item.Repo, item.Path, item.Name)
+		if itemPath == repoPath && item.Type == "folder" {
+			// The root folder of the repository.
+			continue
+		}
+		relativePath := item.Name
+		if strings.HasPrefix(itemPath, repoPath+"/") {
+			relativePath = strings.TrimPrefix(itemPath, repoPath+"/")
+		}
+		items = append(items, ListItem{Path: relativePath, Type: item.Type, Size: item.Size, Modified: item.Modified})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Path < items[j].Path
+	})
+	return items
+}
+
+// Shows the items as a table of their type, size, modification time and path.
+func CreateListTable(items []ListItem, human bool) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "TYPE\tSIZE\tMODIFIED\tPATH")
+	for _, item := range items {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", item.Type, formatListItemSize(item, human), formatListItemTime(item.Modified), FormatListItemPath(item))
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
+
+// Shows the items under the listed path as a tree, like the tree command. If long is true, the size and modification time of each file are shown.
+func CreateListTree(repoPath string, items []ListItem, long, human bool) string {
+	root := newPathTree(repoPath)
+	for _, item := range items {
+		nodes := root.addPath(item.Path)
+		if long && item.Type != "folder" {
+			details := formatListItemSize(item, human)
+			if item.Modified != "" {
+				details += "  " + formatListItemTime(item.Modified)
+			}
+			nodes[len(nodes)-1].details = details
+		}
+	}
+	var builder strings.Builder
+	builder.WriteString(root.name)
+	root.writeChildren(&builder, "", func(node *pathTree) string {
+		if node.details == "" {
+			return node.name
+		}
+		return node.name + "  [" + node.details + "]"
+	})
+	return builder.String()
+}
+
+// Returns the path of the item. Folders are shown with a trailing slash, to tell them apart from files.
+func FormatListItemPath(item ListItem) string {
+	if item.Type == "folder" {
+		return item.Path + "/"
+	}
+	return item.Path
+}
+
+func formatListItemSize(item ListItem, human bool) string {
+	if item.Type == "folder" {
+		return "-"
+	}
+	return FormatSize(item.Size, human)
+}
+
+// Returns the size in bytes, or in a human readable format such as 1.5K or 20.3M.
+func FormatSize(size int64, human bool) string {
+	if !human || size < 1024 {
+		return strconv.FormatInt(size, 10)
+	}
+	units := "KMGTP"
+	value := float64(size) / 1024
+	unit := 0
+	for value >= 1024 && unit < len(units)-1 {
+		value /= 1024
+		unit++
+	}
+	return fmt.Sprintf("%.1f%c", value, units[unit])
+}
+
+// Shows the Artifactory time in the local time zone, without seconds.
+func formatListItemTime(artifactoryTime string) string {
+	parsed, err := time.Parse("2006-01-02T15:04:05.000Z07:00", artifactoryTime)
+	if err != nil {
+		return artifactoryTime
+	}
+	return parsed.Local().Format("2006-01-02 15:04")
+}
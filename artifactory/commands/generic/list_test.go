@@ -0,0 +1,112 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+func TestSplitRepoPath(t *testing.T) {
+	tests := []struct {
+		repoPath string
+		expected [3]string
+	}{
+		{"repo", [3]string{"repo", ".", "."}},
+		{"repo/a", [3]string{"repo", ".", "a"}},
+		{"repo/a/b/c.txt", [3]string{"repo", "a/b", "c.txt"}},
+	}
+	for _, test := range tests {
+		repo, dir, name := splitRepoPath(test.repoPath)
+		if actual := [3]string{repo, dir, name}; actual != test.expected {
+			t.Errorf("Repo path '%s': expected %v, got %v.", test.repoPath, test.expected, actual)
+		}
+	}
+}
+
+func TestCreateListItems(t *testing.T) {
+	resultItems := []clientutils.ResultItem{
+		{Repo: "repo", Path: "a", Name: "c.txt", Type: "file", Size: 3, Modified: "2019-01-01T10:00:00.000Z"},
+		{Repo: "repo", Path: "a", Name: "b", Type: "folder"},
+		{Repo: "repo", Path: "a/b", Name: "d.txt", Type: "file", Size: 4},
+	}
+	expected := []ListItem{
+		{Path: "b", Type: "folder"},
+		{Path: "b/d.txt", Type: "file", Size: 4},
+		{Path: "c.txt", Type: "file", Size: 3, Modified: "2019-01-01T10:00:00.000Z"},
+	}
+	if actual := createListItems(resultItems, "repo/a"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+
+	// The root folder of a repository is not listed, and a listed file is returned with its name.
+	resultItems = []clientutils.ResultItem{{Repo: "repo", Path: ".", Name: ".", Type: "folder"}}
+	if actual := createListItems(resultItems, "repo"); len(actual) != 0 {
+		t.Errorf("Expected no items, got %v.", actual)
+	}
+	resultItems = []clientutils.ResultItem{{Repo: "repo", Path: "a", Name: "c.txt", Type: "file", Size: 3}}
+	expected = []ListItem{{Path: "c.txt", Type: "file", Size: 3}}
+	if actual := createListItems(resultItems, "repo/a/c.txt"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestCreateListTree(t *testing.T) {
+	items := []ListItem{
+		{Path: "a", Type: "folder"},
+		{Path: "a.txt", Type: "file", Size: 10},
+		{Path: "a/b", Type: "folder"},
+		{Path: "a/b/c.txt", Type: "file", Size: 2048},
+		{Path: "a/d.txt", Type: "file", Size: 1},
+	}
+	expected := "repo/path\n" +
+		"├── a\n" +
+		"│   ├── b\n" +
+		"│   │   └── c.txt  [2.0K]\n" +
+		"│   └── d.txt  [1]\n" +
+		"└── a.txt  [10]"
+	if actual := CreateListTree("repo/path", items, true, true); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+	expected = "repo/path\n" +
+		"├── a\n" +
+		"│   ├── b\n" +
+		"│   │   └── c.txt\n" +
+		"│   └── d.txt\n" +
+		"└── a.txt"
+	if actual := CreateListTree("repo/path", items, false, false); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
+
+func TestCreateListTable(t *testing.T) {
+	items := []ListItem{
+		{Path: "a", Type: "folder"},
+		{Path: "a/b.txt", Type: "file", Size: 1536, Modified: "invalid"},
+	}
+	expected := "TYPE    SIZE  MODIFIED  PATH\n" +
+		"folder  -               a/\n" +
+		"file    1.5K  invalid   a/b.txt"
+	if actual := CreateListTable(items, true); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
+
+func TestFormatSize(t *testing.T) {
+	tests := []struct {
+		size     int64
+		human    bool
+		expected string
+	}{
+		{1536, false, "1536"},
+		{1023, true, "1023"},
+		{1536, true, "1.5K"},
+		{20 * 1024 * 1024, true, "20.0M"},
+		{3 * 1024 * 1024 * 1024, true, "3.0G"},
+	}
+	for _, test := range tests {
+		if actual := FormatSize(test.size, test.human); actual != test.expected {
+			t.Errorf("Size %d: expected %s, got %s.", test.size, test.expected, actual)
+		}
+	}
+}
@@ -1,14 +1,17 @@
 package generic
 
 import (
+	commandsutils "github.com/jfrog/jfrog-cli-go/artifactory/commands/utils"
 	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
-	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type MoveCommand struct {
 	GenericCommand
+	onConflict    string
+	verify        bool
+	discrepancies []VerificationDiscrepancy
 }
 
 func NewMoveCommand() *MoveCommand {
@@ -23,27 +26,50 @@ func (mc *MoveCommand) Run() error {
 		return err
 	}
 
-	// Move Loop:
-	for i := 0; i < len(mc.Spec().Files); i++ {
+	if mc.onConflict != "" || mc.verify {
+		mc.discrepancies, err = moveCopyWithPolicy(services.MOVE, mc.spec, mc.onConflict, mc.verify, mc.dryRun, servicesManager, mc.result)
+		return err
+	}
 
-		moveParams, err := getMoveParams(mc.Spec().Get(i))
+	// Move the file groups concurrently. A failure in one group does not stop the other groups.
+	groupResults := make([]commandsutils.Result, len(mc.Spec().Files))
+	groupErrors := runSpecFileGroups(mc.Spec(), mc.threads, func(index int, file *spec.File) error {
+		moveParams, err := getMoveParams(file)
 		if err != nil {
-			log.Error(err)
-			continue
+			return err
 		}
+		success, failed, err := servicesManager.Move(moveParams)
+		groupResults[index].SetSuccessCount(success)
+		groupResults[index].SetFailCount(failed)
+		return err
+	})
+	addGroupResults(mc.result, groupResults)
+	return combineSpecFileGroupErrors(groupErrors)
+}
 
-		partialSuccess, partialFailed, err := servicesManager.Move(moveParams)
-		success := mc.result.SuccessCount() + partialSuccess
-		mc.result.SetSuccessCount(success)
-		failed := mc.result.FailCount() + partialFailed
-		mc.result.SetFailCount(failed)
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-	}
-	return err
+func (mc *MoveCommand) OnConflict() string {
+	return mc.onConflict
+}
+
+// Sets what to do when a different file already exists in the target path. See OnConflictSkip and the other policies.
+func (mc *MoveCommand) SetOnConflict(onConflict string) *MoveCommand {
+	mc.onConflict = onConflict
+	return mc
+}
+
+func (mc *MoveCommand) Verify() bool {
+	return mc.verify
+}
+
+// If set, the checksums of the target files are compared with the source files after the move operation.
+func (mc *MoveCommand) SetVerify(verify bool) *MoveCommand {
+	mc.verify = verify
+	return mc
+}
 
+// The files whose checksums in the target path were found different from the source files by the verification.
+func (mc *MoveCommand) Discrepancies() []VerificationDiscrepancy {
+	return mc.discrepancies
 }
 
 func (mc *MoveCommand) CommandName() string {
@@ -0,0 +1,209 @@
+package generic
+
+import (
+	"errors"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Determines what the copy and move commands do when a different file already exists in the target path.
+// A file with the same checksum as the source file is not considered a conflict.
+const (
+	// Leave both the source and the existing target file as is.
+	OnConflictSkip = "skip"
+	// Replace the existing target file.
+	OnConflictOverwrite = "overwrite"
+	// Fail the command before copying or moving any file.
+	OnConflictFail = "fail"
+	// Copy or move the file next to the existing file, with a numeric suffix added to its name.
+	OnConflictRename = "rename"
+)
+
+func ValidateOnConflict(onConflict string) error {
+	switch onConflict {
+	case "", OnConflictSkip, OnConflictOverwrite, OnConflictFail, OnConflictRename:
+		return nil
+	}
+	return errorutils.CheckError(errors.New("The on-conflict value '" + onConflict + "' is invalid. Possible values are: " +
+		strings.Join([]string{OnConflictSkip, OnConflictOverwrite, OnConflictFail, OnConflictRename}, ", ") + "."))
+}
+
+// A file found by the verification pass, whose content in the target path is different from the source file.
+type VerificationDiscrepancy struct {
+	Source       string `json:"source"`
+	Target       string `json:"target"`
+	ExpectedSha1 string `json:"expectedSha1"`
+	ActualSha1   string `json:"actualSha1"`
+}
+
+type moveCopyOperation struct {
+	source     serviceutils.ResultItem
+	targetPath string
+}
+
+// Copies or moves the files matched by the spec one by one, applying the conflict policy to files which already exist in the target.
+// All the target paths are checked before any file is copied or moved, so that with the 'fail' policy nothing is changed.
+// If verify is true, the checksums of the target files are compared with the source files after the operation.
+func moveCopyWithPolicy(moveType services.MoveType, specFiles *spec.SpecFiles, onConflict string, verify, dryRun bool,
+	servicesManager *artifactory.ArtifactoryServicesManager, result *utils.Result) ([]VerificationDiscrepancy, error) {
+	if onConflict == "" {
+		onConflict = OnConflictOverwrite
+	}
+	operations, err := planMoveCopy(specFiles, onConflict, servicesManager)
+	if err != nil {
+		return nil, err
+	}
+
+	var performed []moveCopyOperation
+	for _, operation := range operations {
+		sourcePath := operation.source.GetItemRelativePath()
+		if dryRun {
+			log.Info("[Dry run] "+moveCopyMessage(moveType), sourcePath, "to", operation.targetPath)
+			result.SetSuccessCount(result.SuccessCount() + 1)
+			continue
+		}
+		log.Info(moveCopyMessage(moveType), sourcePath, "to", operation.targetPath)
+		if err = moveCopyItem(moveType, sourcePath, operation.targetPath, servicesManager); err != nil {
+			log.Error(err)
+			result.SetFailCount(result.FailCount() + 1)
+			continue
+		}
+		result.SetSuccessCount(result.SuccessCount() + 1)
+		performed = append(performed, operation)
+	}
+	if !verify {
+		return nil, nil
+	}
+	return verifyMoveCopy(performed, servicesManager)
+}
+
+func planMoveCopy(specFiles *spec.SpecFiles, onConflict string, servicesManager *artifactory.ArtifactoryServicesManager) ([]moveCopyOperation, error) {
+	var operations, conflicts []moveCopyOperation
+	// Target paths planned by previous operations, which may not exist yet.
+	plannedTargets := make(map[string]bool)
+	for i := 0; i < len(specFiles.Files); i++ {
+		file := specFiles.Get(i)
+		searchParams, err := GetSearchParams(file)
+		if err != nil {
+			return nil, err
+		}
+		searchParams.IncludeDirs = false
+		flat, err := file.IsFlat(false)
+		if err != nil {
+			return nil, err
+		}
+		resultItems, err := servicesManager.SearchFiles(searchParams)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resultItems {
+			targetPath, err := getMoveCopyTargetPath(file, flat, item)
+			if err != nil {
+				return nil, err
+			}
+			operation := moveCopyOperation{source: item, targetPath: targetPath}
+			conflict, err := isMoveCopyConflict(operation, plannedTargets, servicesManager)
+			if err != nil {
+				return nil, err
+			}
+			if conflict {
+				switch onConflict {
+				case OnConflictSkip:
+					log.Info("Skipping", item.GetItemRelativePath(), "- a different file already exists in", targetPath)
+					continue
+				case OnConflictFail:
+					conflicts = append(conflicts, operation)
+					continue
+				case OnConflictRename:
+					operation.targetPath, err = findFreeTargetPath(targetPath, func(candidate string) (bool, error) {
+						if plannedTargets[candidate] {
+							return true, nil
+						}
+						return itemExists(candidate, servicesManager)
+					})
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+			plannedTargets[operation.targetPath] = true
+			operations = append(operations, operation)
+		}
+	}
+	if len(conflicts) > 0 {
+		for _, conflict := range conflicts {
+			log.Error("A different file already exists in", conflict.targetPath)
+		}
+		return nil, errorutils.CheckError(errors.New("Found " + strconv.Itoa(len(conflicts)) + " conflicting files in the target. No files were copied or moved."))
+	}
+	return operations, nil
+}
+
+// Returns true if a different file already exists in the operation target path.
+func isMoveCopyConflict(operation moveCopyOperation, plannedTargets map[string]bool, servicesManager *artifactory.ArtifactoryServicesManager) (bool, error) {
+	if plannedTargets[operation.targetPath] {
+		return true, nil
+	}
+	targetSha1, exists, err := getItemSha1(operation.targetPath, servicesManager)
+	if err != nil || !exists {
+		return false, err
+	}
+	return targetSha1 != operation.source.Actual_Sha1, nil
+}
+
+// Returns the first path, in the form of <name>-<number><extension>, which does not exist.
+func findFreeTargetPath(targetPath string, exists func(string) (bool, error)) (string, error) {
+	dir, name := path.Split(targetPath)
+	extension := path.Ext(name)
+	baseName := strings.TrimSuffix(name, extension)
+	for i := 1; ; i++ {
+		candidate := dir + baseName + "-" + strconv.Itoa(i) + extension
+		taken, err := exists(candidate)
+		if err != nil {
+			return "", err
+		}
+		if !taken {
+			return candidate, nil
+		}
+	}
+}
+
+func verifyMoveCopy(operations []moveCopyOperation, servicesManager *artifactory.ArtifactoryServicesManager) ([]VerificationDiscrepancy, error) {
+	log.Info("Verifying the checksums of", len(operations), "target files...")
+	var discrepancies []VerificationDiscrepancy
+	for _, operation := range operations {
+		targetSha1, _, err := getItemSha1(operation.targetPath, servicesManager)
+		if err != nil {
+			return discrepancies, err
+		}
+		if targetSha1 != operation.source.Actual_Sha1 {
+			discrepancies = append(discrepancies, VerificationDiscrepancy{
+				Source:       operation.source.GetItemRelativePath(),
+				Target:       operation.targetPath,
+				ExpectedSha1: operation.source.Actual_Sha1,
+				ActualSha1:   targetSha1,
+			})
+		}
+	}
+	if len(discrepancies) > 0 {
+		return discrepancies, errorutils.CheckError(errors.New("The verification found " + strconv.Itoa(len(discrepancies)) + " files with unexpected checksums."))
+	}
+	log.Info("All target files were verified.")
+	return nil, nil
+}
+
+func moveCopyMessage(moveType services.MoveType) string {
+	if moveType == services.MOVE {
+		return "Moving"
+	}
+	return "Copying"
+}
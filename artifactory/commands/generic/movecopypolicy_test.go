@@ -0,0 +1,38 @@
+package generic
+
+import "testing"
+
+func TestValidateOnConflict(t *testing.T) {
+	for _, onConflict := range []string{"", OnConflictSkip, OnConflictOverwrite, OnConflictFail, OnConflictRename} {
+		if err := ValidateOnConflict(onConflict); err != nil {
+			t.Error(err)
+		}
+	}
+	if err := ValidateOnConflict("replace"); err == nil {
+		t.Error("Expected an error for an invalid on-conflict value.")
+	}
+}
+
+func TestFindFreeTargetPath(t *testing.T) {
+	existing := map[string]bool{"repo/a/lib-1.jar": true, "repo/a/lib-2.jar": true, "repo/a/README-1": true}
+	exists := func(candidate string) (bool, error) {
+		return existing[candidate], nil
+	}
+	tests := []struct {
+		targetPath string
+		expected   string
+	}{
+		{"repo/a/lib.jar", "repo/a/lib-3.jar"},
+		{"repo/a/app.zip", "repo/a/app-1.zip"},
+		{"repo/a/README", "repo/a/README-2"},
+	}
+	for _, test := range tests {
+		actual, err := findFreeTargetPath(test.targetPath, exists)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != test.expected {
+			t.Errorf("Expected %s, got %s.", test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package generic
+
+import (
+	"sort"
+	"strings"
+)
+
+// A tree of folders and files, which is shown like the output of the tree command.
+// It is used for showing both the search results and the listed items.
+type pathTree struct {
+	name string
+	// The total size of the files under the node, if the sizes are summed up.
+	size int64
+	// The details of the item of the node, shown after its name.
+	details  string
+	children map[string]*pathTree
+}
+
+func newPathTree(name string) *pathTree {
+	return &pathTree{name: name, children: make(map[string]*pathTree)}
+}
+
+// Adds the path to the tree, and returns the nodes of its folders and of the path itself, from the top folder down.
+func (tree *pathTree) addPath(itemPath string) []*pathTree {
+	var nodes []*pathTree
+	node := tree
+	for _, name := range strings.Split(strings.TrimSuffix(itemPath, "/"), "/") {
+		child, exists := node.children[name]
+		if !exists {
+			child = newPathTree(name)
+			node.children[name] = child
+		}
+		nodes = append(nodes, child)
+		node = child
+	}
+	return nodes
+}
+
+func (tree *pathTree) sortedChildren() []*pathTree {
+	children := make([]*pathTree, 0, len(tree.children))
+	for _, child := range tree.children {
+		children = append(children, child)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].name < children[j].name
+	})
+	return children
+}
+
+// Writes the sub-trees of the node, a line for each node, which shows the label of the node.
+func (tree *pathTree) writeChildren(builder *strings.Builder, indent string, label func(*pathTree) string) {
+	children := tree.sortedChildren()
+	for i, child := range children {
+		connector, childIndent := "├── ", "│   "
+		if i == len(children)-1 {
+			connector, childIndent = "└── ", "    "
+		}
+		builder.WriteString("\n" + indent + connector + label(child))
+		child.writeChildren(builder, indent+childIndent, label)
+	}
+}
@@ -7,12 +7,10 @@ import (
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services"
 	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
-	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
 type PropsCommand struct {
-	props   string
-	threads int
+	props string
 	GenericCommand
 }
 
@@ -20,12 +18,9 @@ func NewPropsCommand() *PropsCommand {
 	return &PropsCommand{GenericCommand: *NewGenericCommand()}
 }
 
-func (pc *PropsCommand) Threads() int {
-	return pc.threads
-}
-
+// Returns the PropsCommand, so that the props setters can be chained after SetThreads.
 func (pc *PropsCommand) SetThreads(threads int) *PropsCommand {
-	pc.threads = threads
+	pc.GenericCommand.SetThreads(threads)
 	return pc
 }
 
@@ -57,22 +52,26 @@ func createPropsServiceManager(threads int, artDetails *config.ArtifactoryDetail
 	return artifactory.New(&artAuth, serviceConfig)
 }
 
-func searchItems(spec *spec.SpecFiles, servicesManager *artifactory.ArtifactoryServicesManager) (resultItems []clientutils.ResultItem) {
-	for i := 0; i < len(spec.Files); i++ {
-		searchParams, err := getSearchParamsForProps(spec.Get(i))
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-
-		currentResultItems, err := servicesManager.SearchFiles(searchParams)
+// Searches the file groups of the spec, with up to 'threads' groups searched concurrently.
+// If any of the groups fails, the errors of all the failed groups are returned.
+func searchItems(specFiles *spec.SpecFiles, threads int, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.ResultItem, error) {
+	groupResultItems := make([][]clientutils.ResultItem, len(specFiles.Files))
+	groupErrors := runSpecFileGroups(specFiles, threads, func(index int, file *spec.File) error {
+		searchParams, err := getSearchParamsForProps(file)
 		if err != nil {
-			log.Error(err)
-			continue
+			return err
 		}
+		groupResultItems[index], err = servicesManager.SearchFiles(searchParams)
+		return err
+	})
+	if err := combineSpecFileGroupErrors(groupErrors); err != nil {
+		return nil, err
+	}
+	var resultItems []clientutils.ResultItem
+	for _, currentResultItems := range groupResultItems {
 		resultItems = append(resultItems, currentResultItems...)
 	}
-	return
+	return resultItems, nil
 }
 
 func GetPropsParams(resultItems []clientutils.ResultItem, properties string) (propsParams services.PropsParams) {
@@ -0,0 +1,65 @@
+package generic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+)
+
+func TestPropsCommandSetters(t *testing.T) {
+	setPropsCommand := NewSetPropsCommand()
+	setPropsCommand.SetThreads(3).SetProps("a=1")
+	if setPropsCommand.Threads() != 3 || setPropsCommand.Props() != "a=1" {
+		t.Error("Expected 3 threads and the properties a=1, got", setPropsCommand.Threads(), setPropsCommand.Props())
+	}
+}
+
+func TestPropsCommandFailedGroup(t *testing.T) {
+	log.SetDefaultLogger()
+	mutex := new(sync.Mutex)
+	var propsRequests []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/search/aql":
+			body, _ := ioutil.ReadAll(r.Body)
+			if strings.Contains(string(body), "forbidden") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			fmt.Fprint(w, `{"results":[{"repo":"repo","path":"dir","name":"a.jar","type":"file"}]}`)
+		case strings.HasPrefix(r.URL.Path, "/api/storage/"):
+			mutex.Lock()
+			propsRequests = append(propsRequests, r.Method+" "+r.URL.Path)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	specFiles := spec.NewBuilder().Pattern("repo/dir/*").BuildSpec()
+	specFiles.Files = append(specFiles.Files, spec.NewBuilder().Pattern("repo/forbidden/*").BuildSpec().Files...)
+
+	propsCommand := NewPropsCommand().SetProps("key=value").SetThreads(2)
+	propsCommand.SetSpec(specFiles).SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"})
+	setPropsCommand := NewSetPropsCommand().SetPropsCommand(*propsCommand)
+	if err := setPropsCommand.Run(); err == nil || !strings.HasPrefix(err.Error(), "File group 2:") {
+		t.Error("Expected the error of the second file group, got", err)
+	}
+	deletePropsCommand := NewDeletePropsCommand().SetPropsCommand(*propsCommand)
+	if err := deletePropsCommand.Run(); err == nil || !strings.HasPrefix(err.Error(), "File group 2:") {
+		t.Error("Expected the error of the second file group, got", err)
+	}
+	// The properties are not set or deleted on the partial results of the search.
+	if len(propsRequests) != 0 {
+		t.Error("Expected no properties requests, got", propsRequests)
+	}
+}
@@ -0,0 +1,69 @@
+package generic
+
+import (
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Exports the properties of the artifacts matched by the spec to a properties mapping file.
+// The exported file can be applied on another server, using the set-props command with the --from-file option.
+type PropsExportCommand struct {
+	GenericCommand
+	outputFile string
+}
+
+func NewPropsExportCommand() *PropsExportCommand {
+	return &PropsExportCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (pe *PropsExportCommand) OutputFile() string {
+	return pe.outputFile
+}
+
+func (pe *PropsExportCommand) SetOutputFile(outputFile string) *PropsExportCommand {
+	pe.outputFile = outputFile
+	return pe
+}
+
+func (pe *PropsExportCommand) CommandName() string {
+	return "rt_props_export"
+}
+
+// The mapping file is written only if all the groups of the spec are searched successfully,
+// so that a partial export is not mistaken for a complete one.
+func (pe *PropsExportCommand) Run() error {
+	rtDetails, err := pe.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+
+	resultItems, err := searchItems(pe.Spec(), pe.threads, servicesManager)
+	if err != nil {
+		return err
+	}
+	mappings := searchResultToPropsMappings(aqlResultToSearchResult(resultItems))
+	log.Info("Exporting the properties of", len(mappings), "artifacts to", pe.outputFile)
+	err = WritePropsMappingFile(pe.outputFile, mappings)
+	if err != nil {
+		return err
+	}
+	pe.result.SetSuccessCount(len(mappings))
+	return nil
+}
+
+// Artifacts without properties are not included in the mappings.
+func searchResultToPropsMappings(searchResult []SearchResult) []PropsMapping {
+	mappings := []PropsMapping{}
+	for _, result := range searchResult {
+		if len(result.Props) == 0 {
+			continue
+		}
+		mappings = append(mappings, PropsMapping{Path: result.Path, Props: propsToString(result.Props)})
+	}
+	return mappings
+}
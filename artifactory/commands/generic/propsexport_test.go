@@ -0,0 +1,57 @@
+package generic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+)
+
+func TestPropsExportFailedGroup(t *testing.T) {
+	log.SetDefaultLogger()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path != "/api/search/aql" || strings.Contains(string(body), "forbidden") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"results":[{"repo":"repo","path":"dir","name":"a.jar","type":"file","properties":[{"key":"key","value":"value"}]}]}`)
+	}))
+	defer ts.Close()
+	tempDir, err := ioutil.TempDir("", "props-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	outputFile := filepath.Join(tempDir, "mapping.csv")
+
+	exportCommand := NewPropsExportCommand().SetOutputFile(outputFile)
+	exportCommand.SetSpec(spec.NewBuilder().Pattern("repo/dir/*").BuildSpec()).SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"})
+	if err = exportCommand.Run(); err != nil {
+		t.Fatal(err)
+	}
+	if content, err := ioutil.ReadFile(outputFile); err != nil || !strings.Contains(string(content), "repo/dir/a.jar,key=value") {
+		t.Error("Expected the properties of repo/dir/a.jar to be exported, got", string(content), err)
+	}
+	if err = os.Remove(outputFile); err != nil {
+		t.Fatal(err)
+	}
+
+	specFiles := spec.NewBuilder().Pattern("repo/dir/*").BuildSpec()
+	specFiles.Files = append(specFiles.Files, spec.NewBuilder().Pattern("repo/forbidden/*").BuildSpec().Files...)
+	exportCommand.SetSpec(specFiles)
+	if err = exportCommand.Run(); err == nil || !strings.HasPrefix(err.Error(), "File group 2:") {
+		t.Error("Expected the error of the second file group, got", err)
+	}
+	if _, err = os.Stat(outputFile); !os.IsNotExist(err) {
+		t.Error("Expected the mapping file not to be written when a file group fails, got", err)
+	}
+}
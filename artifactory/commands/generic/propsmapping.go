@@ -0,0 +1,193 @@
+package generic
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// A single row of a properties mapping file.
+// Path is a path or a wildcard pattern in Artifactory, and Props is a list of properties in the form of "key1=value1;key2=value2,value3".
+// A backslash, comma, semicolon or equals sign which is part of a key or a value is escaped with a backslash, for example "key=a\,b".
+type PropsMapping struct {
+	Path  string `json:"path"`
+	Props string `json:"props"`
+}
+
+var propsMappingCsvHeader = []string{"path", "props"}
+
+// Reads a properties mapping file. The file format is determined by its extension, which can be .csv or .json.
+// A JSON file holds an array of PropsMapping objects.
+// A CSV file holds 'path,props' rows, optionally preceded by a 'path,props' header row.
+func ReadPropsMappingFile(filePath string) ([]PropsMapping, error) {
+	if err := validatePropsMappingFileExtension(filePath); err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var mappings []PropsMapping
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		err = json.Unmarshal(content, &mappings)
+		if err != nil {
+			return nil, errorutils.CheckError(errors.New("Failed to parse the properties mapping file '" + filePath + "': " + err.Error()))
+		}
+	case ".csv":
+		reader := csv.NewReader(strings.NewReader(string(content)))
+		reader.FieldsPerRecord = len(propsMappingCsvHeader)
+		reader.TrimLeadingSpace = true
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, errorutils.CheckError(errors.New("Failed to parse the properties mapping file '" + filePath + "': " + err.Error()))
+		}
+		for i, record := range records {
+			if i == 0 && record[0] == propsMappingCsvHeader[0] && record[1] == propsMappingCsvHeader[1] {
+				continue
+			}
+			mappings = append(mappings, PropsMapping{Path: record[0], Props: record[1]})
+		}
+	}
+	for _, mapping := range mappings {
+		if mapping.Path == "" {
+			return nil, errorutils.CheckError(errors.New("The properties mapping file '" + filePath + "' contains a row with an empty path."))
+		}
+	}
+	return mappings, nil
+}
+
+// Writes a properties mapping file, in the format determined by its extension. See ReadPropsMappingFile.
+func WritePropsMappingFile(filePath string, mappings []PropsMapping) error {
+	if err := validatePropsMappingFileExtension(filePath); err != nil {
+		return err
+	}
+	var content []byte
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".json":
+		var err error
+		content, err = json.MarshalIndent(mappings, "", "  ")
+		if err != nil {
+			return errorutils.CheckError(err)
+		}
+	case ".csv":
+		builder := new(strings.Builder)
+		writer := csv.NewWriter(builder)
+		writer.Write(propsMappingCsvHeader)
+		for _, mapping := range mappings {
+			writer.Write([]string{mapping.Path, mapping.Props})
+		}
+		writer.Flush()
+		if err := writer.Error(); err != nil {
+			return errorutils.CheckError(err)
+		}
+		content = []byte(builder.String())
+	}
+	return errorutils.CheckError(ioutil.WriteFile(filePath, content, os.ModePerm))
+}
+
+func validatePropsMappingFileExtension(filePath string) error {
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".csv", ".json":
+		return nil
+	}
+	return errorutils.CheckError(errors.New("The properties mapping file '" + filePath + "' should have a .csv or .json extension."))
+}
+
+// Escapes the separators of a properties string, when they are part of a key or a value.
+var propsStringEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `;`, `\;`, `=`, `\=`)
+
+// Artifactory accepts escaped separators in the properties of its REST API, but not an escaped backslash.
+var propsParamEscaper = strings.NewReplacer(`,`, `\,`, `;`, `\;`, `=`, `\=`)
+
+// Converts properties to a string in the form of "key1=value1;key2=value2,value3", sorted by key.
+// The separators which are part of a key or a value are escaped, so that the string can be parsed by parsePropsString.
+func propsToString(props map[string][]string) string {
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		values := append([]string{}, props[key]...)
+		sort.Strings(values)
+		for j, value := range values {
+			values[j] = propsStringEscaper.Replace(value)
+		}
+		pairs[i] = propsStringEscaper.Replace(key) + "=" + strings.Join(values, ",")
+	}
+	return strings.Join(pairs, ";")
+}
+
+// Parses a properties string in the form of "key1=value1;key2=value2,value3", in which separators may be escaped with a backslash.
+func parsePropsString(propsString string) (map[string][]string, error) {
+	props := make(map[string][]string)
+	var key string
+	var values []string
+	hasKey := false
+	token := new(strings.Builder)
+	addProp := func() error {
+		if !hasKey && token.Len() == 0 {
+			// An empty property, such as the one after a trailing semicolon.
+			return nil
+		}
+		if !hasKey || key == "" {
+			return errorutils.CheckError(errors.New("Invalid property '" + token.String() + "' in '" + propsString + "'. Properties should be in the form of key1=value1;key2=value2,value3."))
+		}
+		props[key] = append(props[key], append(values, token.String())...)
+		key, values, hasKey = "", nil, false
+		token.Reset()
+		return nil
+	}
+	runes := []rune(propsString)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '\\' && i+1 < len(runes):
+			i++
+			token.WriteRune(runes[i])
+		case c == '=' && !hasKey:
+			key, hasKey = token.String(), true
+			token.Reset()
+		case c == ',' && hasKey:
+			values = append(values, token.String())
+			token.Reset()
+		case c == ';':
+			if err := addProp(); err != nil {
+				return nil, err
+			}
+		default:
+			token.WriteRune(c)
+		}
+	}
+	if err := addProp(); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
+
+// Converts properties to the properties parameter of the Artifactory REST API, in which the separators which are part of a value are escaped.
+func propsToParam(props map[string][]string) string {
+	keys := make([]string, 0, len(props))
+	for key := range props {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, key := range keys {
+		values := make([]string, len(props[key]))
+		for j, value := range props[key] {
+			values[j] = propsParamEscaper.Replace(value)
+		}
+		pairs[i] = url.QueryEscape(key) + "=" + url.QueryEscape(strings.Join(values, ","))
+	}
+	return strings.Join(pairs, ";")
+}
@@ -0,0 +1,93 @@
+package generic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPropsMappingFileRoundTrip(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "props-mapping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	searchResult := []SearchResult{
+		{Path: "repo/a.jar", Props: map[string][]string{"platform": {"linux", "darwin"}, "git.sha": {"abc"}}},
+		{Path: "repo/no-props.jar"},
+		{Path: "repo/dir/b, c.jar", Props: map[string][]string{"git.sha": {"def"}}},
+	}
+	expected := []PropsMapping{
+		{Path: "repo/a.jar", Props: "git.sha=abc;platform=darwin,linux"},
+		{Path: "repo/dir/b, c.jar", Props: "git.sha=def"},
+	}
+	mappings := searchResultToPropsMappings(searchResult)
+	if !reflect.DeepEqual(expected, mappings) {
+		t.Fatalf("Expected %v, got %v.", expected, mappings)
+	}
+	for _, fileName := range []string{"mapping.csv", "mapping.json"} {
+		filePath := filepath.Join(tempDir, fileName)
+		if err = WritePropsMappingFile(filePath, mappings); err != nil {
+			t.Fatal(err)
+		}
+		actual, err := ReadPropsMappingFile(filePath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("%s: expected %v, got %v.", fileName, expected, actual)
+		}
+	}
+}
+
+func TestReadPropsMappingFileWithoutCsvHeader(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "props-mapping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	filePath := filepath.Join(tempDir, "mapping.csv")
+	if err = ioutil.WriteFile(filePath, []byte("repo/*.zip,os=linux\nrepo/a.jar,\"a=1;b=2\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	actual, err := ReadPropsMappingFile(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []PropsMapping{{Path: "repo/*.zip", Props: "os=linux"}, {Path: "repo/a.jar", Props: "a=1;b=2"}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+	if _, err = ReadPropsMappingFile(filepath.Join(tempDir, "mapping.txt")); err == nil {
+		t.Error("Expected an error for an unsupported file extension.")
+	}
+}
+
+func TestPropsStringRoundTrip(t *testing.T) {
+	props := map[string][]string{
+		"build,name": {"a;b", "c=d"},
+		"path":       {`C:\dir`, "x,y"},
+		"empty":      {""},
+	}
+	propsString := propsToString(props)
+	expected := `build\,name=a\;b,c\=d;empty=;path=C:\\dir,x\,y`
+	if propsString != expected {
+		t.Errorf("Expected %s, got %s.", expected, propsString)
+	}
+	actual, err := parsePropsString(propsString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(props, actual) {
+		t.Errorf("Expected %v, got %v.", props, actual)
+	}
+	if _, err = parsePropsString("a=1;b"); err == nil {
+		t.Error("Expected an error for a property without a value.")
+	}
+	if _, err = parsePropsString("=1"); err == nil {
+		t.Error("Expected an error for a property without a key.")
+	}
+}
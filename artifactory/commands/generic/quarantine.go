@@ -0,0 +1,91 @@
+package generic
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Properties added to quarantined items. The original properties of the items are kept as is.
+const (
+	QuarantineDeletionIdProp   = "quarantine.deletionId"
+	QuarantineOriginalPathProp = "quarantine.originalPath"
+	QuarantineDeletedProp      = "quarantine.deleted"
+)
+
+// The time layout at the beginning of a deletion ID.
+const deletionIdTimeLayout = "20060102-150405"
+
+// Quarantined items are stored in the quarantine repository under <deletion ID>/<original path>.
+// A deletion ID starts with the deletion time, followed by a random suffix, for example 20191020-134512-9f1c2e7a.
+func newDeletionId(deletionTime time.Time) (string, error) {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return deletionTime.UTC().Format(deletionIdTimeLayout) + "-" + hex.EncodeToString(suffix), nil
+}
+
+// Returns the deletion time encoded in the deletion ID.
+func parseDeletionIdTime(deletionId string) (time.Time, error) {
+	if len(deletionId) < len(deletionIdTimeLayout) {
+		return time.Time{}, errorutils.CheckError(errors.New("Invalid deletion ID: " + deletionId))
+	}
+	deletionTime, err := time.Parse(deletionIdTimeLayout, deletionId[:len(deletionIdTimeLayout)])
+	if err != nil {
+		return time.Time{}, errorutils.CheckError(errors.New("Invalid deletion ID: " + deletionId))
+	}
+	return deletionTime, nil
+}
+
+func getQuarantinePath(quarantineRepo, deletionId string, item serviceutils.ResultItem) string {
+	return path.Join(quarantineRepo, deletionId, item.GetItemRelativePath())
+}
+
+// Moves the items to the quarantine repository, instead of deleting them.
+// Returns the deletion ID, which can be used later to restore the items.
+func quarantineItems(items []serviceutils.ResultItem, quarantineRepo string, dryRun bool, servicesManager *artifactory.ArtifactoryServicesManager) (deletionId string, successCount int, err error) {
+	deletedTime := time.Now()
+	deletionId, err = newDeletionId(deletedTime)
+	if err != nil {
+		return
+	}
+	for _, item := range items {
+		if item.Repo == quarantineRepo {
+			log.Error("Skipping " + item.GetItemRelativePath() + " - items in the quarantine repository cannot be quarantined.")
+			continue
+		}
+		sourcePath := strings.TrimSuffix(item.GetItemRelativePath(), "/")
+		targetPath := getQuarantinePath(quarantineRepo, deletionId, item)
+		if dryRun {
+			log.Info("[Dry run] Moving", sourcePath, "to quarantine:", targetPath)
+			successCount++
+			continue
+		}
+		log.Info("Moving", sourcePath, "to quarantine:", targetPath)
+		if e := moveCopyItem(services.MOVE, sourcePath, targetPath, servicesManager); e != nil {
+			log.Error(e)
+			continue
+		}
+		props := &serviceutils.Properties{Properties: []serviceutils.Property{
+			{Key: QuarantineDeletionIdProp, Value: deletionId},
+			{Key: QuarantineOriginalPathProp, Value: sourcePath},
+			{Key: QuarantineDeletedProp, Value: deletedTime.UTC().Format(time.RFC3339)},
+		}}
+		if e := setItemProps(targetPath, props.ToEncodedString(), servicesManager); e != nil {
+			log.Error(e)
+			continue
+		}
+		successCount++
+	}
+	return
+}
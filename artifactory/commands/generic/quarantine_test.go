@@ -0,0 +1,59 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+func TestDeletionId(t *testing.T) {
+	deletionTime := time.Date(2019, 10, 20, 13, 45, 12, 0, time.UTC)
+	deletionId, err := newDeletionId(deletionTime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := parseDeletionIdTime(deletionId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.Equal(deletionTime) {
+		t.Errorf("Expected %v, got %v.", deletionTime, parsed)
+	}
+	if _, err = parseDeletionIdTime("my-folder"); err == nil {
+		t.Error("Expected an error for an invalid deletion ID.")
+	}
+	item := serviceutils.ResultItem{Repo: "libs", Path: "org/acme", Name: "a.jar"}
+	if actual := getQuarantinePath("quarantine", deletionId, item); actual != "quarantine/"+deletionId+"/libs/org/acme/a.jar" {
+		t.Errorf("Unexpected quarantine path: %s", actual)
+	}
+}
+
+func TestSelectPurgeableDeletions(t *testing.T) {
+	now := time.Date(2019, 10, 20, 0, 0, 0, 0, time.UTC)
+	deletions := []serviceutils.ResultItem{
+		{Repo: "quarantine", Path: ".", Name: "20191001-100000-aaaaaaaa", Type: "folder"},
+		{Repo: "quarantine", Path: ".", Name: "20191019-100000-bbbbbbbb", Type: "folder"},
+		{Repo: "quarantine", Path: ".", Name: "other", Type: "folder"},
+	}
+	expected := []serviceutils.ResultItem{deletions[0]}
+	if actual := selectPurgeableDeletions(deletions, now, 7); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+	expected = deletions[:2]
+	if actual := selectPurgeableDeletions(deletions, now, 0); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestCreateRestoreAql(t *testing.T) {
+	expected := `items.find({"$and":[{"repo":"quarantine"},{"type":"any"},{"@quarantine.deletionId":"20191020-134512-9f1c2e7a"}]}).include("repo","path","name","type","property")`
+	if actual := createRestoreAql("quarantine", "20191020-134512-9f1c2e7a", ""); actual != expected {
+		t.Errorf("Expected %s, got %s.", expected, actual)
+	}
+	expected = `items.find({"$and":[{"repo":"quarantine"},{"type":"any"},{"@quarantine.originalPath":{"$match":"libs/org/*"}}]}).include("repo","path","name","type","property")`
+	if actual := createRestoreAql("quarantine", "", "libs/org/*"); actual != expected {
+		t.Errorf("Expected %s, got %s.", expected, actual)
+	}
+}
@@ -0,0 +1,104 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Permanently deletes quarantined items, which were deleted more than the specified number of days ago.
+type QuarantinePurgeCommand struct {
+	GenericCommand
+	quarantineRepo string
+	olderThanDays  int
+}
+
+func NewQuarantinePurgeCommand() *QuarantinePurgeCommand {
+	return &QuarantinePurgeCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (qpc *QuarantinePurgeCommand) QuarantineRepo() string {
+	return qpc.quarantineRepo
+}
+
+func (qpc *QuarantinePurgeCommand) SetQuarantineRepo(quarantineRepo string) *QuarantinePurgeCommand {
+	qpc.quarantineRepo = quarantineRepo
+	return qpc
+}
+
+func (qpc *QuarantinePurgeCommand) OlderThanDays() int {
+	return qpc.olderThanDays
+}
+
+func (qpc *QuarantinePurgeCommand) SetOlderThanDays(olderThanDays int) *QuarantinePurgeCommand {
+	qpc.olderThanDays = olderThanDays
+	return qpc
+}
+
+func (qpc *QuarantinePurgeCommand) CommandName() string {
+	return "rt_quarantine_purge"
+}
+
+func (qpc *QuarantinePurgeCommand) Run() error {
+	if qpc.quarantineRepo == "" {
+		return errorutils.CheckError(errors.New("A quarantine repository must be specified."))
+	}
+	rtDetails, err := qpc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, qpc.dryRun)
+	if err != nil {
+		return err
+	}
+	// Each deletion is stored in a folder named by its deletion ID, under the root of the quarantine repository.
+	query := createItemsAql([]string{`{"repo":` + quoteAql(qpc.quarantineRepo) + `}`, `{"path":"."}`, `{"type":"folder"}`}, []string{"repo", "path", "name", "type"})
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return err
+	}
+	deletions, err := parseAqlResult(content)
+	if err != nil {
+		return err
+	}
+	toPurge := selectPurgeableDeletions(deletions, time.Now(), qpc.olderThanDays)
+	if len(toPurge) == 0 {
+		log.Info("No quarantined deletions older than", qpc.olderThanDays, "days.")
+		return nil
+	}
+	for _, deletion := range toPurge {
+		fmt.Println("  " + deletion.GetItemRelativePath())
+	}
+	if !qpc.quiet && !cliutils.InteractiveConfirm("Are you sure you want to permanently delete the above quarantined deletions?") {
+		return nil
+	}
+	deletedCount, err := servicesManager.DeleteFiles(toPurge)
+	qpc.result.SetSuccessCount(deletedCount)
+	qpc.result.SetFailCount(len(toPurge) - deletedCount)
+	return err
+}
+
+// Returns the deletion folders, which were created more than olderThanDays days before now.
+// Folders which are not named by a deletion ID are ignored.
+func selectPurgeableDeletions(deletions []serviceutils.ResultItem, now time.Time, olderThanDays int) []serviceutils.ResultItem {
+	threshold := now.Add(-time.Duration(olderThanDays) * 24 * time.Hour)
+	var result []serviceutils.ResultItem
+	for _, deletion := range deletions {
+		deletionTime, err := parseDeletionIdTime(deletion.Name)
+		if err != nil {
+			log.Debug("Skipping", deletion.GetItemRelativePath(), "-", err.Error())
+			continue
+		}
+		if deletionTime.Before(threshold) {
+			result = append(result, deletion)
+		}
+	}
+	return result
+}
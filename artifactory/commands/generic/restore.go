@@ -0,0 +1,131 @@
+package generic
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Restores items deleted into the quarantine repository back to their original location.
+// The items are selected either by a deletion ID or by a pattern, matched against their original path.
+type RestoreCommand struct {
+	GenericCommand
+	quarantineRepo string
+	deletionId     string
+	pattern        string
+}
+
+func NewRestoreCommand() *RestoreCommand {
+	return &RestoreCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (rc *RestoreCommand) QuarantineRepo() string {
+	return rc.quarantineRepo
+}
+
+func (rc *RestoreCommand) SetQuarantineRepo(quarantineRepo string) *RestoreCommand {
+	rc.quarantineRepo = quarantineRepo
+	return rc
+}
+
+func (rc *RestoreCommand) DeletionId() string {
+	return rc.deletionId
+}
+
+func (rc *RestoreCommand) SetDeletionId(deletionId string) *RestoreCommand {
+	rc.deletionId = deletionId
+	return rc
+}
+
+func (rc *RestoreCommand) Pattern() string {
+	return rc.pattern
+}
+
+func (rc *RestoreCommand) SetPattern(pattern string) *RestoreCommand {
+	rc.pattern = pattern
+	return rc
+}
+
+func (rc *RestoreCommand) CommandName() string {
+	return "rt_restore"
+}
+
+func (rc *RestoreCommand) Run() error {
+	if rc.quarantineRepo == "" {
+		return errorutils.CheckError(errors.New("A quarantine repository must be specified."))
+	}
+	rtDetails, err := rc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+	query := createRestoreAql(rc.quarantineRepo, rc.deletionId, rc.pattern)
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return err
+	}
+	items, err := parseAqlResult(content)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		log.Info("No quarantined items found.")
+		return nil
+	}
+	for _, item := range items {
+		if err := rc.restoreItem(item, servicesManager); err != nil {
+			log.Error(err)
+			rc.result.SetFailCount(rc.result.FailCount() + 1)
+			continue
+		}
+		rc.result.SetSuccessCount(rc.result.SuccessCount() + 1)
+	}
+	return nil
+}
+
+func (rc *RestoreCommand) restoreItem(item serviceutils.ResultItem, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	quarantinePath := path.Join(item.Repo, item.Path, item.Name)
+	originalPath := getItemProp(item, QuarantineOriginalPathProp)
+	if originalPath == "" {
+		return errorutils.CheckError(errors.New("The original path of " + quarantinePath + " is unknown."))
+	}
+	exists, err := itemExists(originalPath, servicesManager)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errorutils.CheckError(errors.New("Cannot restore " + quarantinePath + " - " + originalPath + " already exists."))
+	}
+	if rc.dryRun {
+		log.Info("[Dry run] Restoring", quarantinePath, "to", originalPath)
+		return nil
+	}
+	log.Info("Restoring", quarantinePath, "to", originalPath)
+	if err = moveCopyItem(services.MOVE, quarantinePath, originalPath, servicesManager); err != nil {
+		return err
+	}
+	return deleteItemProps(originalPath, []string{QuarantineDeletionIdProp, QuarantineOriginalPathProp, QuarantineDeletedProp}, servicesManager)
+}
+
+// Returns an AQL query for the quarantined items of a deletion ID, or with an original path matching the pattern.
+func createRestoreAql(quarantineRepo, deletionId, pattern string) string {
+	criteria := []string{`{"repo":` + quoteAql(quarantineRepo) + `}`, `{"type":"any"}`}
+	if deletionId != "" {
+		criteria = append(criteria, fmt.Sprintf(`{"@%s":%s}`, QuarantineDeletionIdProp, quoteAql(deletionId)))
+	}
+	if pattern != "" {
+		criteria = append(criteria, fmt.Sprintf(`{"@%s":{"$match":%s}}`, QuarantineOriginalPathProp, quoteAql(pattern)))
+	}
+	return createItemsAql(criteria, []string{"repo", "path", "name", "type", "property"})
+}
@@ -0,0 +1,285 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"gopkg.in/yaml.v2"
+)
+
+// A retention policy, read from a YAML file. For example:
+//
+//	rules:
+//	  - name: snapshots
+//	    pattern: libs-snapshot-local/org/acme/app/*
+//	    keepLatest: 5
+//	    versionFolders: true
+//	  - name: stale-generic
+//	    pattern: generic-local/*
+//	    olderThanDays: 90
+//	    notDownloadedSinceDays: 30
+//	protect:
+//	  buildArtifacts: true
+//	  props:
+//	    - retain=true
+type RetentionPolicy struct {
+	Rules   []RetentionRule  `yaml:"rules"`
+	Protect RetentionProtect `yaml:"protect"`
+}
+
+// A rule selects artifacts to delete. All the conditions set in a rule must be met for an artifact to be deleted.
+type RetentionRule struct {
+	Name string `yaml:"name"`
+	// Artifacts path pattern, in the form of <repository name>/<repository path>. Sub-folders are included.
+	Pattern string `yaml:"pattern"`
+	// Keep the newest N artifacts in each folder. 0 means that no artifact is kept by this condition.
+	KeepLatest int `yaml:"keepLatest"`
+	// If true, KeepLatest applies to version folders: the newest N sub-folders of the base folder of the pattern are kept,
+	// with all the files in their subtrees. The base folder is the part of the pattern before its first wildcard.
+	VersionFolders bool `yaml:"versionFolders"`
+	// Delete only artifacts created more than the specified number of days ago.
+	OlderThanDays int `yaml:"olderThanDays"`
+	// Delete only artifacts which were not downloaded in the specified number of days, or never downloaded.
+	NotDownloadedSinceDays int `yaml:"notDownloadedSinceDays"`
+}
+
+// Artifacts which are never deleted, regardless of the rules.
+type RetentionProtect struct {
+	// Artifacts referenced by a build published to Artifactory, either as artifacts or as dependencies of the build.
+	BuildArtifacts bool `yaml:"buildArtifacts"`
+	// Artifacts which have one of the properties, in the form of "key=value" or "key" for any value. The value may include wildcards.
+	Props []string `yaml:"props"`
+}
+
+var retentionAqlIncludeFields = []string{"repo", "path", "name", "size", "created", "actual_sha1", "type"}
+
+func ReadRetentionPolicy(policyPath string) (*RetentionPolicy, error) {
+	content, err := ioutil.ReadFile(policyPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	policy := new(RetentionPolicy)
+	err = yaml.UnmarshalStrict(content, policy)
+	if err != nil {
+		return nil, errorutils.CheckError(errors.New("Failed to parse the retention policy '" + policyPath + "': " + err.Error()))
+	}
+	return policy, policy.Validate()
+}
+
+func (policy *RetentionPolicy) Validate() error {
+	if len(policy.Rules) == 0 {
+		return errorutils.CheckError(errors.New("The retention policy should include at least one rule."))
+	}
+	for i := range policy.Rules {
+		rule := &policy.Rules[i]
+		if rule.Name == "" {
+			rule.Name = "rule-" + strconv.Itoa(i+1)
+		}
+		if rule.Pattern == "" || strings.HasPrefix(rule.Pattern, "/") || strings.HasPrefix(rule.Pattern, "*") {
+			return errorutils.CheckError(errors.New("The retention rule '" + rule.Name + "' should have a pattern in the form of <repository name>/<repository path>."))
+		}
+		if rule.KeepLatest < 0 || rule.OlderThanDays < 0 || rule.NotDownloadedSinceDays < 0 {
+			return errorutils.CheckError(errors.New("The retention rule '" + rule.Name + "' should not have negative values."))
+		}
+		if rule.KeepLatest == 0 && rule.OlderThanDays == 0 && rule.NotDownloadedSinceDays == 0 {
+			return errorutils.CheckError(errors.New("The retention rule '" + rule.Name + "' should set at least one of keepLatest, olderThanDays and notDownloadedSinceDays."))
+		}
+		if rule.VersionFolders && rule.KeepLatest == 0 {
+			return errorutils.CheckError(errors.New("The retention rule '" + rule.Name + "' sets versionFolders without keepLatest."))
+		}
+	}
+	return nil
+}
+
+// Returns the AQL query for the files matching the rule pattern.
+// If withConditions is true, the age conditions of the rule are included in the query.
+func (rule *RetentionRule) createAql(withConditions bool) string {
+	criteria := []string{createPatternAqlCriteria(rule.Pattern), `{"type":"file"}`}
+	if withConditions {
+		if rule.OlderThanDays > 0 {
+			criteria = append(criteria, fmt.Sprintf(`{"created":{"$before":"%dd"}}`, rule.OlderThanDays))
+		}
+		if rule.NotDownloadedSinceDays > 0 {
+			criteria = append(criteria, fmt.Sprintf(`{"$or":[{"stat.downloaded":{"$before":"%dd"}},{"stat.downloads":{"$eq":null}}]}`, rule.NotDownloadedSinceDays))
+		}
+	}
+	return createRetentionAql(criteria)
+}
+
+// Returns the folder of the pattern, up to its first wildcard, in the form of <repository name>/<repository path>.
+// The version folders of the rule are the sub-folders of the base folder.
+func (rule *RetentionRule) getBaseFolder() string {
+	var folders []string
+	for _, folder := range strings.Split(strings.TrimSuffix(rule.Pattern, "/"), "/") {
+		if strings.ContainsAny(folder, "*?") {
+			break
+		}
+		folders = append(folders, folder)
+	}
+	return strings.Join(folders, "/")
+}
+
+func (rule *RetentionRule) hasAgeConditions() bool {
+	return rule.OlderThanDays > 0 || rule.NotDownloadedSinceDays > 0
+}
+
+// Returns the AQL queries for the protected files matching the rule pattern.
+func (protect *RetentionProtect) createAqls(rule *RetentionRule) []string {
+	var queries []string
+	patternCriteria := createPatternAqlCriteria(rule.Pattern)
+	if protect.BuildArtifacts {
+		queries = append(queries, createRetentionAql([]string{patternCriteria, `{"artifact.module.build.name":{"$match":"*"}}`}))
+		queries = append(queries, createRetentionAql([]string{patternCriteria, `{"dependency.module.build.name":{"$match":"*"}}`}))
+	}
+	for _, prop := range protect.Props {
+		key, value := prop, "*"
+		if i := strings.Index(prop, "="); i >= 0 {
+			key, value = prop[:i], prop[i+1:]
+		}
+		queries = append(queries, createRetentionAql([]string{patternCriteria, fmt.Sprintf(`{"@%s":{"$match":%s}}`, escapeAqlKey(key), quoteAql(value))}))
+	}
+	return queries
+}
+
+func createRetentionAql(criteria []string) string {
+	return createItemsAql(criteria, retentionAqlIncludeFields)
+}
+
+// Returns an AQL query for the items matching all the criteria.
+func createItemsAql(criteria, includeFields []string) string {
+	include := make([]string, len(includeFields))
+	for i, field := range includeFields {
+		include[i] = quoteAql(field)
+	}
+	return fmt.Sprintf(`items.find({"$and":[%s]}).include(%s)`, strings.Join(criteria, ","), strings.Join(include, ","))
+}
+
+// Converts a <repository name>/<repository path> pattern to AQL criteria.
+// Like the other commands, files in sub-folders of the matched folders are also matched.
+func createPatternAqlCriteria(pattern string) string {
+	repo, repoPath := pattern, ""
+	if i := strings.Index(pattern, "/"); i >= 0 {
+		repo, repoPath = pattern[:i], pattern[i+1:]
+	}
+	repoCriteria := `{"repo":` + quoteAql(repo) + `}`
+	if repoPath == "" || repoPath == "*" {
+		return repoCriteria
+	}
+	dir, name := ".", repoPath
+	if i := strings.LastIndex(repoPath, "/"); i >= 0 {
+		dir, name = repoPath[:i], repoPath[i+1:]
+	}
+	if name == "" {
+		name = "*"
+	}
+	fileCriteria := fmt.Sprintf(`{"path":{"$match":%s},"name":{"$match":%s}}`, quoteAql(dir), quoteAql(name))
+	subFoldersCriteria := fmt.Sprintf(`{"path":{"$match":%s}}`, quoteAql(strings.TrimSuffix(repoPath, "/")))
+	if strings.HasSuffix(repoPath, "/") {
+		subFoldersCriteria = fmt.Sprintf(`{"path":{"$match":%s}}`, quoteAql(repoPath+"*"))
+	}
+	return fmt.Sprintf(`{"$and":[%s,{"$or":[%s,%s]}]}`, repoCriteria, fileCriteria, subFoldersCriteria)
+}
+
+func quoteAql(value string) string {
+	quoted, _ := json.Marshal(value)
+	return string(quoted)
+}
+
+func escapeAqlKey(key string) string {
+	quoted := quoteAql(key)
+	return quoted[1 : len(quoted)-1]
+}
+
+func parseAqlResult(content []byte) ([]clientutils.ResultItem, error) {
+	result := new(clientutils.AqlSearchResult)
+	err := json.Unmarshal(content, result)
+	return result.Results, errorutils.CheckError(err)
+}
+
+// Returns the files which should be deleted because they exceed the number of newest files, or version folders, to keep.
+// Files are grouped by their folder. If baseFolder is set, files are grouped by their version folder instead,
+// which is the sub-folder of baseFolder that they are in, and the version folders are kept or deleted as a whole.
+// Files which are not in a version folder are never selected.
+func selectExceedingLatest(items []clientutils.ResultItem, keepLatest int, baseFolder string) []clientutils.ResultItem {
+	groups := make(map[string][]string)
+	newest := make(map[string]string)
+	for _, item := range items {
+		version, parent := getItemVersion(item, baseFolder)
+		if version == "" {
+			continue
+		}
+		if _, exists := newest[version]; !exists {
+			groups[parent] = append(groups[parent], version)
+		}
+		if item.Created > newest[version] {
+			newest[version] = item.Created
+		}
+	}
+	exceeding := make(map[string]bool)
+	for _, versions := range groups {
+		sort.Slice(versions, func(i, j int) bool {
+			if newest[versions[i]] != newest[versions[j]] {
+				return newest[versions[i]] > newest[versions[j]]
+			}
+			return versions[i] > versions[j]
+		})
+		for i := keepLatest; i < len(versions); i++ {
+			exceeding[versions[i]] = true
+		}
+	}
+	var result []clientutils.ResultItem
+	for _, item := range items {
+		if version, _ := getItemVersion(item, baseFolder); exceeding[version] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Returns the version of the item, which is either the item itself or its version folder, and the folder the version is in.
+// An empty version is returned for items which are not in a version folder.
+func getItemVersion(item clientutils.ResultItem, baseFolder string) (version, parent string) {
+	folder := path.Join(item.Repo, item.Path)
+	if baseFolder == "" {
+		return item.GetItemRelativePath(), folder
+	}
+	if !strings.HasPrefix(folder, baseFolder+"/") {
+		return "", ""
+	}
+	versionFolder := strings.SplitN(strings.TrimPrefix(folder, baseFolder+"/"), "/", 2)[0]
+	return baseFolder + "/" + versionFolder, baseFolder
+}
+
+// Returns the items which are in both lists.
+func intersectItems(items, other []clientutils.ResultItem) []clientutils.ResultItem {
+	paths := make(map[string]bool, len(other))
+	for _, item := range other {
+		paths[item.GetItemRelativePath()] = true
+	}
+	var result []clientutils.ResultItem
+	for _, item := range items {
+		if paths[item.GetItemRelativePath()] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Returns the items which are not in the excluded paths.
+func excludeItems(items []clientutils.ResultItem, excludedPaths map[string]bool) []clientutils.ResultItem {
+	var result []clientutils.ResultItem
+	for _, item := range items {
+		if !excludedPaths[item.GetItemRelativePath()] {
+			result = append(result, item)
+		}
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+func TestRetentionPolicyValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    RetentionRule
+		isValid bool
+	}{
+		{"keepLatest", RetentionRule{Pattern: "repo/a/*", KeepLatest: 3}, true},
+		{"olderThan", RetentionRule{Pattern: "repo", OlderThanDays: 30}, true},
+		{"noPattern", RetentionRule{KeepLatest: 3}, false},
+		{"absolutePattern", RetentionRule{Pattern: "/repo/*", KeepLatest: 3}, false},
+		{"noConditions", RetentionRule{Pattern: "repo/*"}, false},
+		{"negative", RetentionRule{Pattern: "repo/*", OlderThanDays: -1}, false},
+		{"versionFoldersWithoutKeepLatest", RetentionRule{Pattern: "repo/*", OlderThanDays: 1, VersionFolders: true}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := &RetentionPolicy{Rules: []RetentionRule{test.rule}}
+			err := policy.Validate()
+			if test.isValid && err != nil {
+				t.Error(err)
+			}
+			if !test.isValid && err == nil {
+				t.Error("Expected an error.")
+			}
+		})
+	}
+	policy := &RetentionPolicy{Rules: []RetentionRule{{Pattern: "repo", OlderThanDays: 1}}}
+	if err := policy.Validate(); err != nil || policy.Rules[0].Name != "rule-1" {
+		t.Errorf("Expected a default rule name, got '%s' (%v).", policy.Rules[0].Name, err)
+	}
+	if err := new(RetentionPolicy).Validate(); err == nil {
+		t.Error("Expected an error for a policy without rules.")
+	}
+}
+
+func TestCreatePatternAqlCriteria(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"repo", `{"repo":"repo"}`},
+		{"repo/*", `{"repo":"repo"}`},
+		{"repo/a/*.jar", `{"$and":[{"repo":"repo"},{"$or":[{"path":{"$match":"a"},"name":{"$match":"*.jar"}},{"path":{"$match":"a/*.jar"}}]}]}`},
+		{"repo/a/", `{"$and":[{"repo":"repo"},{"$or":[{"path":{"$match":"a"},"name":{"$match":"*"}},{"path":{"$match":"a/*"}}]}]}`},
+	}
+	for _, test := range tests {
+		if actual := createPatternAqlCriteria(test.pattern); actual != test.expected {
+			t.Errorf("Pattern '%s': expected %s, got %s.", test.pattern, test.expected, actual)
+		}
+	}
+}
+
+func TestRetentionProtectCreateAqls(t *testing.T) {
+	rule := &RetentionRule{Pattern: "repo", KeepLatest: 1}
+	protect := &RetentionProtect{BuildArtifacts: true, Props: []string{"retain=true", "keep"}}
+	include := `.include("repo","path","name","size","created","actual_sha1","type")`
+	expected := []string{
+		`items.find({"$and":[{"repo":"repo"},{"artifact.module.build.name":{"$match":"*"}}]})` + include,
+		`items.find({"$and":[{"repo":"repo"},{"dependency.module.build.name":{"$match":"*"}}]})` + include,
+		`items.find({"$and":[{"repo":"repo"},{"@retain":{"$match":"true"}}]})` + include,
+		`items.find({"$and":[{"repo":"repo"},{"@keep":{"$match":"*"}}]})` + include,
+	}
+	if actual := protect.createAqls(rule); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestSelectExceedingLatest(t *testing.T) {
+	items := []clientutils.ResultItem{
+		{Repo: "repo", Path: "a", Name: "1.jar", Created: "2019-01-01"},
+		{Repo: "repo", Path: "a", Name: "2.jar", Created: "2019-01-02"},
+		{Repo: "repo", Path: "a", Name: "3.jar", Created: "2019-01-03"},
+		{Repo: "repo", Path: "b", Name: "1.jar", Created: "2019-01-01"},
+	}
+	expected := []clientutils.ResultItem{items[0]}
+	if actual := selectExceedingLatest(items, 2, ""); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+
+	versions := []clientutils.ResultItem{
+		{Repo: "repo", Path: "lib", Name: "maven-metadata.xml", Created: "2019-01-01"},
+		{Repo: "repo", Path: "lib/1.0", Name: "lib.jar", Created: "2019-01-01"},
+		{Repo: "repo", Path: "lib/1.0", Name: "lib.pom", Created: "2019-01-05"},
+		{Repo: "repo", Path: "lib/1.1", Name: "lib.jar", Created: "2019-01-02"},
+		{Repo: "repo", Path: "lib/1.1/docs", Name: "index.html", Created: "2019-01-06"},
+		{Repo: "repo", Path: "lib/1.2", Name: "lib.jar", Created: "2019-01-03"},
+		{Repo: "repo", Path: "lib/1.2/docs/api", Name: "index.html", Created: "2019-01-03"},
+	}
+	// The files in the sub-folders of a version belong to the version, so 1.1 is the newest version and 1.2 is the oldest.
+	// The metadata file is not in a version folder.
+	expected = []clientutils.ResultItem{versions[5], versions[6]}
+	if actual := selectExceedingLatest(versions, 2, "repo/lib"); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestRetentionRuleGetBaseFolder(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		expected string
+	}{
+		{"repo", "repo"},
+		{"repo/*", "repo"},
+		{"repo/org/acme/*", "repo/org/acme"},
+		{"repo/org/acme/", "repo/org/acme"},
+		{"repo/org/*/snapshots/*", "repo/org"},
+		{"repo/org/1.?/*", "repo/org"},
+	}
+	for _, test := range tests {
+		rule := &RetentionRule{Pattern: test.pattern}
+		if actual := rule.getBaseFolder(); actual != test.expected {
+			t.Errorf("Pattern '%s': expected %s, got %s.", test.pattern, test.expected, actual)
+		}
+	}
+}
+
+func TestCreateCleanupPlanReport(t *testing.T) {
+	plan := []CleanupRulePlan{
+		{Rule: "snapshots", Items: []clientutils.ResultItem{
+			{Repo: "repo", Path: "b", Name: "1.jar", Size: 10},
+			{Repo: "repo", Path: "a", Name: "1.jar", Size: 5},
+			{Repo: "repo", Path: "a", Name: "2.jar", Size: 5},
+		}},
+		{Rule: "empty"},
+	}
+	expected := "Rule 'snapshots': 3 artifacts, 20 bytes\n" +
+		"  repo/a: 2 artifacts, 10 bytes\n" +
+		"  repo/b: 1 artifacts, 10 bytes\n" +
+		"Rule 'empty': 0 artifacts, 0 bytes\n" +
+		"Total: 3 artifacts, 20 reclaimable bytes"
+	if actual := CreateCleanupPlanReport(plan); actual != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, actual)
+	}
+}
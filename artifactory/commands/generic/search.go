@@ -58,27 +58,28 @@ func (sc *SearchCommand) Search() error {
 		return err
 	}
 
-	// Search Loop
+	// Search the file groups concurrently, keeping the results in the order of the groups in the spec.
 	log.Info("Searching artifacts...")
-	var resultItems []clientutils.ResultItem
-	for i := 0; i < len(sc.Spec().Files); i++ {
-
-		searchParams, err := GetSearchParams(sc.Spec().Get(i))
-		if err != nil {
-			log.Error(err)
-			return err
-		}
-
-		currentResultItems, err := servicesManager.SearchFiles(searchParams)
+	groupResultItems := make([][]clientutils.ResultItem, len(sc.Spec().Files))
+	groupErrors := runSpecFileGroups(sc.Spec(), sc.threads, func(index int, file *spec.File) error {
+		searchParams, err := GetSearchParams(file)
 		if err != nil {
 			return err
 		}
+		groupResultItems[index], err = servicesManager.SearchFiles(searchParams)
+		return err
+	})
+	if err = combineSpecFileGroupErrors(groupErrors); err != nil {
+		return err
+	}
+	var resultItems []clientutils.ResultItem
+	for _, currentResultItems := range groupResultItems {
 		resultItems = append(resultItems, currentResultItems...)
 	}
 
 	sc.searchResult = aqlResultToSearchResult(resultItems)
 	clientutils.LogSearchResults(len(resultItems))
-	return err
+	return nil
 }
 
 func aqlResultToSearchResult(aqlResult []clientutils.ResultItem) (result []SearchResult) {
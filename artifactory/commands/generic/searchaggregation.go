@@ -0,0 +1,153 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+const (
+	GroupByRepo      = "repo"
+	GroupByFolder    = "folder"
+	GroupByExtension = "extension"
+	// Followed by the property key, for example "prop:build.name".
+	GroupByPropPrefix = "prop:"
+)
+
+type SearchGroup struct {
+	Key   string `json:"key"`
+	Count int    `json:"count"`
+	Size  int64  `json:"size"`
+}
+
+// Validates the value of the --group-by option.
+func ValidateGroupBy(groupBy string) error {
+	switch {
+	case groupBy == GroupByRepo, groupBy == GroupByFolder, groupBy == GroupByExtension:
+		return nil
+	case strings.HasPrefix(groupBy, GroupByPropPrefix) && len(groupBy) > len(GroupByPropPrefix):
+		return nil
+	}
+	return errorutils.CheckError(errors.New("The --group-by option accepts 'repo', 'folder', 'extension' or 'prop:<property key>'. Got '" + groupBy + "'."))
+}
+
+// Groups the search results by the groupBy criteria, and returns the groups sorted by their total size, largest first.
+// Folders are not included in the groups.
+func GroupSearchResults(searchResult []SearchResult, groupBy string) ([]SearchGroup, error) {
+	if err := ValidateGroupBy(groupBy); err != nil {
+		return nil, err
+	}
+	groups := make(map[string]*SearchGroup)
+	for _, result := range searchResult {
+		if result.Type == "folder" {
+			continue
+		}
+		for _, key := range getGroupKeys(result, groupBy) {
+			group, exists := groups[key]
+			if !exists {
+				group = &SearchGroup{Key: key}
+				groups[key] = group
+			}
+			group.Count++
+			group.Size += result.Size
+		}
+	}
+	sortedGroups := make([]SearchGroup, 0, len(groups))
+	for _, group := range groups {
+		sortedGroups = append(sortedGroups, *group)
+	}
+	sort.Slice(sortedGroups, func(i, j int) bool {
+		if sortedGroups[i].Size != sortedGroups[j].Size {
+			return sortedGroups[i].Size > sortedGroups[j].Size
+		}
+		return sortedGroups[i].Key < sortedGroups[j].Key
+	})
+	return sortedGroups, nil
+}
+
+func getGroupKeys(result SearchResult, groupBy string) []string {
+	switch groupBy {
+	case GroupByRepo:
+		return []string{strings.SplitN(result.Path, "/", 2)[0]}
+	case GroupByFolder:
+		return []string{path.Dir(result.Path)}
+	case GroupByExtension:
+		return []string{strings.TrimPrefix(path.Ext(result.Path), ".")}
+	}
+	values := result.Props[strings.TrimPrefix(groupBy, GroupByPropPrefix)]
+	if len(values) == 0 {
+		return []string{""}
+	}
+	return values
+}
+
+// Returns the total size of the files in the search results.
+func SumSearchResultsSize(searchResult []SearchResult) (size int64) {
+	for _, result := range searchResult {
+		size += result.Size
+	}
+	return
+}
+
+// Returns the search results, including only the requested fields.
+// The field names are the JSON keys of SearchResult.
+func SelectSearchResultFields(searchResult []SearchResult, fields []string) ([]map[string]interface{}, error) {
+	content, err := json.Marshal(searchResult)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var allFields []map[string]interface{}
+	if err = json.Unmarshal(content, &allFields); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, field := range fields {
+		if !isSearchResultField(field) {
+			return nil, errorutils.CheckError(errors.New("Unknown search result field '" + field + "'. The available fields are: path, type, size, created, modified and props."))
+		}
+	}
+	selected := make([]map[string]interface{}, len(allFields))
+	for i, result := range allFields {
+		selected[i] = make(map[string]interface{}, len(fields))
+		for _, field := range fields {
+			if value, exists := result[field]; exists {
+				selected[i][field] = value
+			}
+		}
+	}
+	return selected, nil
+}
+
+func isSearchResultField(field string) bool {
+	switch field {
+	case "path", "type", "size", "created", "modified", "props":
+		return true
+	}
+	return false
+}
+
+// Renders the search results as a tree of folders and files, with the total size in bytes of each node.
+func CreateSearchResultTree(searchResult []SearchResult) string {
+	root := newPathTree("")
+	for _, result := range searchResult {
+		for _, node := range root.addPath(result.Path) {
+			node.size += result.Size
+		}
+	}
+	label := func(node *pathTree) string {
+		return node.name + " (" + strconv.FormatInt(node.size, 10) + ")"
+	}
+	var builder strings.Builder
+	for i, child := range root.sortedChildren() {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString(label(child))
+		child.writeChildren(&builder, "", label)
+	}
+	return builder.String()
+}
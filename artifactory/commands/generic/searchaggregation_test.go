@@ -0,0 +1,73 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+)
+
+var testSearchResult = []SearchResult{
+	{Path: "libs-release/a/x.jar", Type: "file", Size: 10, Props: map[string][]string{"build.name": {"b1"}}},
+	{Path: "libs-release/a/y.pom", Type: "file", Size: 5},
+	{Path: "libs-release/b/z.jar", Type: "file", Size: 30, Props: map[string][]string{"build.name": {"b2"}}},
+	{Path: "libs-snapshot/c/w.jar", Type: "file", Size: 1, Props: map[string][]string{"build.name": {"b1"}}},
+}
+
+func TestGroupSearchResults(t *testing.T) {
+	tests := []struct {
+		groupBy  string
+		expected []SearchGroup
+	}{
+		{GroupByRepo, []SearchGroup{{"libs-release", 3, 45}, {"libs-snapshot", 1, 1}}},
+		{GroupByFolder, []SearchGroup{{"libs-release/b", 1, 30}, {"libs-release/a", 2, 15}, {"libs-snapshot/c", 1, 1}}},
+		{GroupByExtension, []SearchGroup{{"jar", 3, 41}, {"pom", 1, 5}}},
+		{"prop:build.name", []SearchGroup{{"b2", 1, 30}, {"b1", 2, 11}, {"", 1, 5}}},
+	}
+	for _, test := range tests {
+		actual, err := GroupSearchResults(testSearchResult, test.groupBy)
+		if err != nil {
+			t.Error(err)
+		}
+		if !reflect.DeepEqual(test.expected, actual) {
+			t.Errorf("Group by '%s': expected %v, got %v.", test.groupBy, test.expected, actual)
+		}
+	}
+	if _, err := GroupSearchResults(testSearchResult, "prop:"); err == nil {
+		t.Error("Expected an error for an empty property key.")
+	}
+}
+
+func TestSumSearchResultsSize(t *testing.T) {
+	if size := SumSearchResultsSize(testSearchResult); size != 46 {
+		t.Errorf("Expected 46, got %d.", size)
+	}
+}
+
+func TestSelectSearchResultFields(t *testing.T) {
+	actual, err := SelectSearchResultFields(testSearchResult[:1], []string{"path", "size"})
+	if err != nil {
+		t.Error(err)
+	}
+	expected := []map[string]interface{}{{"path": "libs-release/a/x.jar", "size": float64(10)}}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+	if _, err = SelectSearchResultFields(testSearchResult, []string{"checksum"}); err == nil {
+		t.Error("Expected an error for an unknown field.")
+	}
+}
+
+func TestCreateSearchResultTree(t *testing.T) {
+	expected := "libs-release (45)\n" +
+		"├── a (15)\n" +
+		"│   ├── x.jar (10)\n" +
+		"│   └── y.pom (5)\n" +
+		"└── b (30)\n" +
+		"    └── z.jar (30)\n" +
+		"libs-snapshot (1)\n" +
+		"└── c (1)\n" +
+		"    └── w.jar (1)"
+	actual := CreateSearchResultTree(testSearchResult)
+	if actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
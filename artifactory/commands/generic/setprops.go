@@ -31,7 +31,10 @@ func (setProps *SetPropsCommand) Run() error {
 		return err
 	}
 
-	resultItems := searchItems(setProps.Spec(), servicesManager)
+	resultItems, err := searchItems(setProps.Spec(), setProps.threads, servicesManager)
+	if err != nil {
+		return err
+	}
 
 	propsParams := GetPropsParams(resultItems, setProps.props)
 	success, err := servicesManager.SetProps(propsParams)
@@ -0,0 +1,153 @@
+package generic
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jfrog/gofrog/parallel"
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type PropsMappingResult struct {
+	Path    string `json:"path"`
+	Props   string `json:"props"`
+	Success int    `json:"success"`
+	Failure int    `json:"failure"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Sets properties on artifacts according to a properties mapping file.
+// Each row of the file is applied separately, and the rows are processed in parallel.
+type SetPropsFromFileCommand struct {
+	PropsCommand
+	mappingFile   string
+	mappingResult []PropsMappingResult
+}
+
+func NewSetPropsFromFileCommand() *SetPropsFromFileCommand {
+	return &SetPropsFromFileCommand{PropsCommand: *NewPropsCommand()}
+}
+
+func (spf *SetPropsFromFileCommand) MappingFile() string {
+	return spf.mappingFile
+}
+
+func (spf *SetPropsFromFileCommand) SetMappingFile(mappingFile string) *SetPropsFromFileCommand {
+	spf.mappingFile = mappingFile
+	return spf
+}
+
+func (spf *SetPropsFromFileCommand) MappingResult() []PropsMappingResult {
+	return spf.mappingResult
+}
+
+func (spf *SetPropsFromFileCommand) CommandName() string {
+	return "rt_set_properties_from_file"
+}
+
+func (spf *SetPropsFromFileCommand) Run() error {
+	mappings, err := ReadPropsMappingFile(spf.mappingFile)
+	if err != nil {
+		return err
+	}
+	rtDetails, err := spf.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	// The rows are processed in parallel, therefore each row is handled by a single thread.
+	servicesManager, err := createPropsServiceManager(1, rtDetails)
+	if err != nil {
+		return err
+	}
+
+	spf.mappingResult = make([]PropsMappingResult, len(mappings))
+	mutex := new(sync.Mutex)
+	producerConsumer := parallel.NewBounedRunner(spf.threads, false)
+	errorsQueue := clientutils.NewErrorsQueue(1)
+	go func() {
+		defer producerConsumer.Done()
+		for i := range mappings {
+			producerConsumer.AddTaskWithError(spf.createSetPropsTask(servicesManager, mappings, i, mutex), errorsQueue.AddError)
+		}
+	}()
+	producerConsumer.Run()
+	if err = errorsQueue.GetError(); err != nil {
+		return err
+	}
+	return spf.getFailedRowsError()
+}
+
+// Returns an error which lists the rows that failed or matched no artifacts, or nil if all rows succeeded.
+func (spf *SetPropsFromFileCommand) getFailedRowsError() error {
+	var failedRows []string
+	for _, result := range spf.mappingResult {
+		if result.Error != "" {
+			failedRows = append(failedRows, result.Path+": "+result.Error)
+		}
+	}
+	if len(failedRows) == 0 {
+		return nil
+	}
+	return errorutils.CheckError(errors.New(strconv.Itoa(len(failedRows)) + " of the " + strconv.Itoa(len(spf.mappingResult)) + " rows of the properties mapping file failed:\n" + strings.Join(failedRows, "\n")))
+}
+
+// Sets the properties of a single row on the artifacts which match its path.
+// A row whose search fails, or whose path matches no artifacts, is reported with an error in its result.
+func (spf *SetPropsFromFileCommand) createSetPropsTask(servicesManager *artifactory.ArtifactoryServicesManager, mappings []PropsMapping, index int, mutex *sync.Mutex) parallel.TaskFunc {
+	return func(threadId int) error {
+		mapping := mappings[index]
+		result := PropsMappingResult{Path: mapping.Path, Props: mapping.Props}
+		if err := setRowProps(mapping, &result, servicesManager); err != nil {
+			log.Error(err)
+			result.Error = err.Error()
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		spf.mappingResult[index] = result
+		spf.result.SetSuccessCount(spf.result.SuccessCount() + result.Success)
+		spf.result.SetFailCount(spf.result.FailCount() + result.Failure)
+		return nil
+	}
+}
+
+func setRowProps(mapping PropsMapping, result *PropsMappingResult, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	props, err := parsePropsString(mapping.Props)
+	if err != nil {
+		return err
+	}
+	if len(props) == 0 {
+		return errorutils.CheckError(errors.New("No properties were specified for the path."))
+	}
+	rowSpec := spec.NewBuilder().Pattern(mapping.Path).Recursive(true).BuildSpec()
+	searchParams, err := getSearchParamsForProps(rowSpec.Get(0))
+	if err != nil {
+		return err
+	}
+	resultItems, err := servicesManager.SearchFiles(searchParams)
+	if err != nil {
+		return err
+	}
+	if len(resultItems) == 0 {
+		return errorutils.CheckError(errors.New("No artifacts matched the path."))
+	}
+	// The properties are sent by the command rather than by the client, which does not support escaped separators in their values.
+	encodedProps := propsToParam(props)
+	var firstErr error
+	for _, item := range resultItems {
+		if err = setItemProps(item.GetItemRelativePath(), encodedProps, servicesManager); err != nil {
+			result.Failure++
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		result.Success++
+	}
+	return firstErr
+}
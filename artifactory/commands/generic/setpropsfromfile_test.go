@@ -0,0 +1,75 @@
+package generic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+)
+
+func TestSetPropsFromFile(t *testing.T) {
+	log.SetDefaultLogger()
+	mutex := new(sync.Mutex)
+	var setPropsQueries []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/search/aql":
+			body, _ := ioutil.ReadAll(r.Body)
+			switch {
+			case strings.Contains(string(body), "missing"):
+				fmt.Fprint(w, `{"results":[]}`)
+			case strings.Contains(string(body), "forbidden"):
+				w.WriteHeader(http.StatusForbidden)
+			default:
+				fmt.Fprint(w, `{"results":[{"repo":"repo","path":"dir","name":"a.jar","type":"file"}]}`)
+			}
+		case r.Method == http.MethodPut && r.URL.Path == "/api/storage/repo/dir/a.jar":
+			mutex.Lock()
+			setPropsQueries = append(setPropsQueries, r.URL.RawQuery)
+			mutex.Unlock()
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	tempDir, err := ioutil.TempDir("", "props-mapping")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	filePath := filepath.Join(tempDir, "mapping.csv")
+	content := "path,props\nrepo/dir/*,\"key=a\\,b;other=c\"\nrepo/missing/*,key=value\nrepo/forbidden/*,key=value\n"
+	if err = ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	propsCmd := NewSetPropsFromFileCommand().SetMappingFile(filePath)
+	propsCmd.SetThreads(2).SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"})
+	err = propsCmd.Run()
+	if err == nil || !strings.Contains(err.Error(), "repo/missing/*") || !strings.Contains(err.Error(), "repo/forbidden/*") {
+		t.Error("Expected an error listing the unmatched and failed rows, got", err)
+	}
+	results := propsCmd.MappingResult()
+	if results[0].Success != 1 || results[0].Error != "" {
+		t.Error("Expected the first row to succeed, got", results[0])
+	}
+	if results[1].Error != "No artifacts matched the path." {
+		t.Error("Expected the second row to match no artifacts, got", results[1])
+	}
+	if results[2].Error == "" {
+		t.Error("Expected the search of the third row to fail, got", results[2])
+	}
+	// The comma in the value is escaped, so it is not taken as a separator of values.
+	if expected := "recursive=0&properties=key=a%5C%2Cb;other=c"; len(setPropsQueries) != 1 || setPropsQueries[0] != expected {
+		t.Error("Expected a single set properties request with", expected, "but got", setPropsQueries)
+	}
+}
@@ -0,0 +1,64 @@
+package generic
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/gofrog/parallel"
+	commandsutils "github.com/jfrog/jfrog-cli-go/artifactory/commands/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// Runs the handler on each of the spec file groups, with up to 'threads' groups handled concurrently.
+// Returns the error of each group, in the order of the groups in the spec. The handlers should likewise store
+// their results by the group index, so that the results can be combined in the order of the spec.
+func runSpecFileGroups(specFiles *spec.SpecFiles, threads int, handler func(index int, file *spec.File) error) []error {
+	groupErrors := make([]error, len(specFiles.Files))
+	if threads <= 1 || len(specFiles.Files) <= 1 {
+		for i := range specFiles.Files {
+			groupErrors[i] = handler(i, specFiles.Get(i))
+		}
+		return groupErrors
+	}
+	runner := parallel.NewBounedRunner(threads, false)
+	go func() {
+		defer runner.Done()
+		for i := range specFiles.Files {
+			index := i
+			runner.AddTask(func(int) error {
+				groupErrors[index] = handler(index, specFiles.Get(index))
+				return nil
+			})
+		}
+	}()
+	runner.Run()
+	return groupErrors
+}
+
+// Combines the errors of the spec file groups into a single error, which lists each failed group by its position in the spec.
+// The error of a spec with a single group is returned as is.
+func combineSpecFileGroupErrors(groupErrors []error) error {
+	if len(groupErrors) == 1 {
+		return groupErrors[0]
+	}
+	var messages []string
+	for i, err := range groupErrors {
+		if err != nil {
+			messages = append(messages, "File group "+strconv.Itoa(i+1)+": "+err.Error())
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return errorutils.CheckError(errors.New(strings.Join(messages, "\n")))
+}
+
+// Adds the success and failure counts of the spec file groups to the command result.
+func addGroupResults(result *commandsutils.Result, groupResults []commandsutils.Result) {
+	for _, groupResult := range groupResults {
+		result.SetSuccessCount(result.SuccessCount() + groupResult.SuccessCount())
+		result.SetFailCount(result.FailCount() + groupResult.FailCount())
+	}
+}
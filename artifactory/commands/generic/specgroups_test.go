@@ -0,0 +1,69 @@
+package generic
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+)
+
+func TestRunSpecFileGroups(t *testing.T) {
+	builder := spec.NewBuilder()
+	specFiles := new(spec.SpecFiles)
+	for i := 0; i < 10; i++ {
+		specFiles.Files = append(specFiles.Files, builder.Pattern("repo/"+strconv.Itoa(i)+"/*").BuildSpec().Files...)
+	}
+	for _, threads := range []int{0, 1, 3} {
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		patterns := make([]string, len(specFiles.Files))
+		groupErrors := runSpecFileGroups(specFiles, threads, func(index int, file *spec.File) error {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+			// Let the later groups finish first, to make sure the results are still ordered by the groups.
+			time.Sleep(time.Duration(10-index) * time.Millisecond)
+			patterns[index] = file.Pattern
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+			if index%4 == 1 {
+				return errors.New("failed")
+			}
+			return nil
+		})
+		expectedMaxRunning := threads
+		if expectedMaxRunning < 1 {
+			expectedMaxRunning = 1
+		}
+		if maxRunning > expectedMaxRunning {
+			t.Errorf("Threads %d: expected at most %d concurrent groups, got %d.", threads, expectedMaxRunning, maxRunning)
+		}
+		for i, pattern := range patterns {
+			if expected := "repo/" + strconv.Itoa(i) + "/*"; pattern != expected {
+				t.Errorf("Threads %d: expected group %d to have the pattern %s, got %s.", threads, i, expected, pattern)
+			}
+		}
+		err := combineSpecFileGroupErrors(groupErrors)
+		expected := "File group 2: failed\nFile group 6: failed\nFile group 10: failed"
+		if err == nil || err.Error() != expected {
+			t.Errorf("Threads %d: expected the error '%s', got '%v'.", threads, expected, err)
+		}
+	}
+}
+
+func TestCombineSpecFileGroupErrors(t *testing.T) {
+	groupError := errors.New("failed")
+	if err := combineSpecFileGroupErrors([]error{groupError}); err != groupError {
+		t.Errorf("Expected the error of a single group to be returned as is, got '%v'.", err)
+	}
+	if err := combineSpecFileGroupErrors([]error{nil, nil}); err != nil {
+		t.Errorf("Expected no error, got '%v'.", err)
+	}
+}
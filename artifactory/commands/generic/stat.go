@@ -0,0 +1,323 @@
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+type ItemStat struct {
+	Path             string              `json:"path,omitempty"`
+	Type             string              `json:"type,omitempty"`
+	Size             int64               `json:"size"`
+	MimeType         string              `json:"mimeType,omitempty"`
+	Created          string              `json:"created,omitempty"`
+	CreatedBy        string              `json:"createdBy,omitempty"`
+	LastModified     string              `json:"lastModified,omitempty"`
+	ModifiedBy       string              `json:"modifiedBy,omitempty"`
+	Sha1             string              `json:"sha1,omitempty"`
+	Md5              string              `json:"md5,omitempty"`
+	Sha256           string              `json:"sha256,omitempty"`
+	Properties       map[string][]string `json:"properties,omitempty"`
+	DownloadCount    int64               `json:"downloadCount"`
+	LastDownloaded   string              `json:"lastDownloaded,omitempty"`
+	LastDownloadedBy string              `json:"lastDownloadedBy,omitempty"`
+	Builds           []ItemBuild         `json:"builds,omitempty"`
+}
+
+type ItemBuild struct {
+	Name   string `json:"name,omitempty"`
+	Number string `json:"number,omitempty"`
+}
+
+// Shows the details of a file or folder in Artifactory: its checksums, properties, download statistics and the builds it belongs to.
+type StatCommand struct {
+	GenericCommand
+	repoPath string
+	itemStat *ItemStat
+}
+
+func NewStatCommand() *StatCommand {
+	return &StatCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (sc *StatCommand) RepoPath() string {
+	return sc.repoPath
+}
+
+func (sc *StatCommand) SetRepoPath(repoPath string) *StatCommand {
+	sc.repoPath = repoPath
+	return sc
+}
+
+func (sc *StatCommand) ItemStat() *ItemStat {
+	return sc.itemStat
+}
+
+func (sc *StatCommand) CommandName() string {
+	return "rt_stat"
+}
+
+func (sc *StatCommand) Run() error {
+	return sc.Stat()
+}
+
+func (sc *StatCommand) Stat() error {
+	rtDetails, err := sc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+	repoPath := strings.Trim(sc.repoPath, "/")
+	itemStat, err := getItemStorageInfo(repoPath, servicesManager)
+	if err != nil {
+		return err
+	}
+	if itemStat.Properties, err = getItemProps(repoPath, servicesManager); err != nil {
+		return err
+	}
+	if itemStat.Type == "file" {
+		if err = getItemDownloadStats(repoPath, itemStat, servicesManager); err != nil {
+			return err
+		}
+		if itemStat.Builds, err = getItemBuilds(repoPath, servicesManager); err != nil {
+			return err
+		}
+	}
+	sc.itemStat = itemStat
+	return nil
+}
+
+// Sends a GET request to the storage REST API of the item, and returns the response body.
+// If the item has no information of the requested kind, nil is returned.
+func getItemStorageApi(repoPath, query string, servicesManager *artifactory.ArtifactoryServicesManager) ([]byte, error) {
+	artDetails := servicesManager.GetConfig().GetArtDetails()
+	storageUrl, err := serviceutils.BuildArtifactoryUrl(artDetails.GetUrl(), path.Join("api/storage", repoPath), make(map[string]string))
+	if err != nil {
+		return nil, err
+	}
+	if query != "" {
+		storageUrl += "?" + query
+	}
+	httpClientDetails := artDetails.CreateHttpClientDetails()
+	resp, body, _, err := servicesManager.Client().SendGet(storageUrl, true, &httpClientDetails)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return body, nil
+	case resp.StatusCode == http.StatusNotFound && query != "":
+		return nil, nil
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, errorutils.CheckError(errors.New("The path " + repoPath + " does not exist."))
+	}
+	return nil, errorutils.CheckError(errors.New("Failed getting the details of " + repoPath + ". Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+}
+
+func getItemStorageInfo(repoPath string, servicesManager *artifactory.ArtifactoryServicesManager) (*ItemStat, error) {
+	body, err := getItemStorageApi(repoPath, "", servicesManager)
+	if err != nil {
+		return nil, err
+	}
+	return parseItemStorageInfo(body, repoPath)
+}
+
+func parseItemStorageInfo(body []byte, repoPath string) (*ItemStat, error) {
+	storageInfo := &struct {
+		Created      string `json:"created"`
+		CreatedBy    string `json:"createdBy"`
+		LastModified string `json:"lastModified"`
+		ModifiedBy   string `json:"modifiedBy"`
+		MimeType     string `json:"mimeType"`
+		// The size is returned as a string.
+		Size      string `json:"size"`
+		Checksums struct {
+			Sha1   string `json:"sha1"`
+			Md5    string `json:"md5"`
+			Sha256 string `json:"sha256"`
+		} `json:"checksums"`
+		Children []interface{} `json:"children"`
+	}{}
+	if err := json.Unmarshal(body, storageInfo); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	itemStat := &ItemStat{
+		Path:         repoPath,
+		Type:         "file",
+		MimeType:     storageInfo.MimeType,
+		Created:      storageInfo.Created,
+		CreatedBy:    storageInfo.CreatedBy,
+		LastModified: storageInfo.LastModified,
+		ModifiedBy:   storageInfo.ModifiedBy,
+		Sha1:         storageInfo.Checksums.Sha1,
+		Md5:          storageInfo.Checksums.Md5,
+		Sha256:       storageInfo.Checksums.Sha256,
+	}
+	// Only folders have children.
+	if storageInfo.Children != nil {
+		itemStat.Type = "folder"
+	}
+	if storageInfo.Size != "" {
+		size, err := strconv.ParseInt(storageInfo.Size, 10, 64)
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		itemStat.Size = size
+	}
+	return itemStat, nil
+}
+
+func getItemProps(repoPath string, servicesManager *artifactory.ArtifactoryServicesManager) (map[string][]string, error) {
+	body, err := getItemStorageApi(repoPath, "properties", servicesManager)
+	if err != nil || body == nil {
+		return nil, err
+	}
+	props := &struct {
+		Properties map[string][]string `json:"properties"`
+	}{}
+	return props.Properties, errorutils.CheckError(json.Unmarshal(body, props))
+}
+
+func getItemDownloadStats(repoPath string, itemStat *ItemStat, servicesManager *artifactory.ArtifactoryServicesManager) error {
+	body, err := getItemStorageApi(repoPath, "stats", servicesManager)
+	if err != nil || body == nil {
+		return err
+	}
+	stats := &struct {
+		DownloadCount int64 `json:"downloadCount"`
+		// The time of the last download, in milliseconds since the epoch.
+		LastDownloaded   int64  `json:"lastDownloaded"`
+		LastDownloadedBy string `json:"lastDownloadedBy"`
+	}{}
+	if err = json.Unmarshal(body, stats); err != nil {
+		return errorutils.CheckError(err)
+	}
+	itemStat.DownloadCount = stats.DownloadCount
+	itemStat.LastDownloadedBy = stats.LastDownloadedBy
+	if stats.LastDownloaded > 0 {
+		itemStat.LastDownloaded = time.Unix(0, stats.LastDownloaded*int64(time.Millisecond)).UTC().Format(time.RFC3339)
+	}
+	return nil
+}
+
+// Returns the builds which include the file as an artifact, sorted by name and number.
+func getItemBuilds(repoPath string, servicesManager *artifactory.ArtifactoryServicesManager) ([]ItemBuild, error) {
+	repo, dir, name := splitRepoPath(repoPath)
+	criteria := []string{`{"repo":` + quoteAql(repo) + `}`, `{"path":` + quoteAql(dir) + `}`, `{"name":` + quoteAql(name) + `}`}
+	query := createItemsAql(criteria, []string{"artifact.module.build.name", "artifact.module.build.number"})
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return nil, err
+	}
+	return parseItemBuildsAqlResult(content)
+}
+
+func parseItemBuildsAqlResult(content []byte) ([]ItemBuild, error) {
+	result := &struct {
+		Results []struct {
+			Artifacts []struct {
+				Modules []struct {
+					Builds []struct {
+						Name   string `json:"build.name"`
+						Number string `json:"build.number"`
+					} `json:"builds"`
+				} `json:"modules"`
+			} `json:"artifacts"`
+		} `json:"results"`
+	}{}
+	if err := json.Unmarshal(content, result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	// The same build is returned for each of its modules which include the file.
+	builds := make(map[ItemBuild]bool)
+	for _, item := range result.Results {
+		for _, artifact := range item.Artifacts {
+			for _, module := range artifact.Modules {
+				for _, build := range module.Builds {
+					builds[ItemBuild{Name: build.Name, Number: build.Number}] = true
+				}
+			}
+		}
+	}
+	var itemBuilds []ItemBuild
+	for build := range builds {
+		itemBuilds = append(itemBuilds, build)
+	}
+	sort.Slice(itemBuilds, func(i, j int) bool {
+		if itemBuilds[i].Name != itemBuilds[j].Name {
+			return itemBuilds[i].Name < itemBuilds[j].Name
+		}
+		return itemBuilds[i].Number < itemBuilds[j].Number
+	})
+	return itemBuilds, nil
+}
+
+// Shows the details of the item, one per line, followed by its properties and builds.
+func CreateStatOutput(itemStat *ItemStat, human bool) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fields := [][2]string{
+		{"Path", itemStat.Path},
+		{"Type", itemStat.Type},
+		{"Size", FormatSize(itemStat.Size, human)},
+		{"Mime type", itemStat.MimeType},
+		{"Created", itemStat.Created},
+		{"Created by", itemStat.CreatedBy},
+		{"Last modified", itemStat.LastModified},
+		{"Modified by", itemStat.ModifiedBy},
+		{"SHA1", itemStat.Sha1},
+		{"MD5", itemStat.Md5},
+		{"SHA256", itemStat.Sha256},
+	}
+	if itemStat.Type == "file" {
+		fields = append(fields, [][2]string{
+			{"Downloads", strconv.FormatInt(itemStat.DownloadCount, 10)},
+			{"Last downloaded", itemStat.LastDownloaded},
+			{"Last downloaded by", itemStat.LastDownloadedBy},
+		}...)
+	}
+	for _, field := range fields {
+		if field[1] != "" {
+			fmt.Fprintf(writer, "%s:\t%s\n", field[0], field[1])
+		}
+	}
+	writer.Flush()
+	if len(itemStat.Properties) > 0 {
+		buffer.WriteString("Properties:\n")
+		var keys []string
+		for key := range itemStat.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			buffer.WriteString("  " + key + " = " + strings.Join(itemStat.Properties[key], ", ") + "\n")
+		}
+	}
+	if len(itemStat.Builds) > 0 {
+		buffer.WriteString("Builds:\n")
+		for _, build := range itemStat.Builds {
+			buffer.WriteString("  " + build.Name + "/" + build.Number + "\n")
+		}
+	}
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
@@ -0,0 +1,64 @@
+package generic
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseItemStorageInfo(t *testing.T) {
+	body := []byte(`{"repo":"repo","path":"/a/b.jar","created":"2019-01-01T10:00:00.000Z","createdBy":"admin","size":"1024","mimeType":"application/java-archive","checksums":{"sha1":"1","md5":"2","sha256":"3"}}`)
+	expected := &ItemStat{Path: "repo/a/b.jar", Type: "file", Size: 1024, MimeType: "application/java-archive", Created: "2019-01-01T10:00:00.000Z", CreatedBy: "admin", Sha1: "1", Md5: "2", Sha256: "3"}
+	actual, err := parseItemStorageInfo(body, "repo/a/b.jar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+
+	body = []byte(`{"repo":"repo","path":"/a","created":"2019-01-01T10:00:00.000Z","children":[]}`)
+	actual, err = parseItemStorageInfo(body, "repo/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.Type != "folder" {
+		t.Errorf("Expected a folder, got %s.", actual.Type)
+	}
+}
+
+func TestParseItemBuildsAqlResult(t *testing.T) {
+	content := []byte(`{"results":[{"artifacts":[{"modules":[{"builds":[{"build.name":"b","build.number":"2"},{"build.name":"a","build.number":"1"}]}]},{"modules":[{"builds":[{"build.name":"b","build.number":"2"}]}]}]}]}`)
+	expected := []ItemBuild{{Name: "a", Number: "1"}, {Name: "b", Number: "2"}}
+	actual, err := parseItemBuildsAqlResult(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestCreateStatOutput(t *testing.T) {
+	itemStat := &ItemStat{
+		Path:          "repo/a/b.jar",
+		Type:          "file",
+		Size:          2048,
+		Sha1:          "1",
+		DownloadCount: 3,
+		Properties:    map[string][]string{"b": {"1", "2"}, "a": {"3"}},
+		Builds:        []ItemBuild{{Name: "build", Number: "1"}},
+	}
+	expected := "Path:       repo/a/b.jar\n" +
+		"Type:       file\n" +
+		"Size:       2.0K\n" +
+		"SHA1:       1\n" +
+		"Downloads:  3\n" +
+		"Properties:\n" +
+		"  a = 3\n" +
+		"  b = 1, 2\n" +
+		"Builds:\n" +
+		"  build/1"
+	if actual := CreateStatOutput(itemStat, true); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
@@ -0,0 +1,267 @@
+package generic
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/auth"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/httpclient"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/httputils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Transfers artifacts from one Artifactory server to another.
+// The artifacts are streamed from the source server to the target server, without being stored locally.
+type TransferCommand struct {
+	GenericCommand
+	targetRtDetails *config.ArtifactoryDetails
+	targetAuth      auth.ArtifactoryDetails
+	targetClient    *httpclient.HttpClient
+	sourceManager   *artifactory.ArtifactoryServicesManager
+}
+
+func NewTransferCommand() *TransferCommand {
+	return &TransferCommand{GenericCommand: *NewGenericCommand()}
+}
+
+func (tc *TransferCommand) TargetRtDetails() *config.ArtifactoryDetails {
+	return tc.targetRtDetails
+}
+
+func (tc *TransferCommand) SetTargetRtDetails(targetRtDetails *config.ArtifactoryDetails) *TransferCommand {
+	tc.targetRtDetails = targetRtDetails
+	return tc
+}
+
+func (tc *TransferCommand) CommandName() string {
+	return "rt_transfer"
+}
+
+func (tc *TransferCommand) Run() error {
+	err := tc.init()
+	if err != nil {
+		return err
+	}
+
+	// Transfer the file groups one after the other. A failure in one group does not stop the other groups.
+	groupErrors := make([]error, len(tc.spec.Files))
+	for i := 0; i < len(tc.spec.Files); i++ {
+		groupErrors[i] = tc.transferGroup(tc.spec.Get(i))
+	}
+	return combineSpecFileGroupErrors(groupErrors)
+}
+
+// Transfers the artifacts of a single spec file group. The artifacts which fail to be transferred are counted as failures,
+// while a failure to find the artifacts of the group is returned.
+func (tc *TransferCommand) transferGroup(file *spec.File) error {
+	searchParams, err := GetSearchParams(file)
+	if err != nil {
+		return err
+	}
+	resultItems, err := tc.sourceManager.SearchFiles(searchParams)
+	if err != nil {
+		return err
+	}
+	flat, err := file.IsFlat(false)
+	if err != nil {
+		return err
+	}
+	for _, item := range resultItems {
+		if item.Type == "folder" {
+			continue
+		}
+		targetPath, err := getMoveCopyTargetPath(file, flat, item)
+		if err != nil {
+			log.Error(err)
+			tc.result.SetFailCount(tc.result.FailCount() + 1)
+			continue
+		}
+		err = tc.transferItem(item, targetPath)
+		if err != nil {
+			log.Error(err)
+			tc.result.SetFailCount(tc.result.FailCount() + 1)
+			continue
+		}
+		tc.result.SetSuccessCount(tc.result.SuccessCount() + 1)
+	}
+	return nil
+}
+
+func (tc *TransferCommand) init() (err error) {
+	tc.sourceManager, err = utils.CreateServiceManager(tc.rtDetails, false)
+	if err != nil {
+		return
+	}
+	tc.targetAuth, err = tc.targetRtDetails.CreateArtAuthConfig()
+	if err != nil {
+		return
+	}
+	certPath, err := utils.GetJfrogSecurityDir()
+	if err != nil {
+		return
+	}
+	tc.targetClient, err = httpclient.ClientBuilder().
+		SetCertificatesPath(certPath).
+		SetInsecureTls(tc.targetRtDetails.InsecureTls).
+		Build()
+	return
+}
+
+func (tc *TransferCommand) transferItem(item serviceutils.ResultItem, targetPath string) error {
+	sourcePath := item.GetItemRelativePath()
+	exists, err := tc.isTransferred(item, targetPath)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Info("Skipping", sourcePath, "- it already exists in the target server with the same checksum.")
+		return nil
+	}
+	if tc.dryRun {
+		log.Info("[Dry run] Transferring", sourcePath, "to", targetPath)
+		return nil
+	}
+
+	targetUrl, err := serviceutils.BuildArtifactoryUrl(tc.targetAuth.GetUrl(), targetPath, make(map[string]string))
+	if err != nil {
+		return err
+	}
+	if len(item.Properties) > 0 {
+		targetUrl += ";" + (&serviceutils.Properties{Properties: item.Properties}).ToEncodedString()
+	}
+
+	deployed, err := tc.checksumDeploy(item, targetUrl)
+	if err != nil || deployed {
+		if deployed {
+			log.Info("Transferred", sourcePath, "to", targetPath, "using checksum deploy.")
+		}
+		return err
+	}
+
+	log.Info("Transferring", sourcePath, "to", targetPath)
+	reader, err := tc.sourceManager.ReadRemoteFile(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	req, err := http.NewRequest("PUT", targetUrl, reader)
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	req.ContentLength = item.Size
+	httpClientDetails := tc.createTargetHttpClientDetails(item)
+	setAuthentication(req, httpClientDetails)
+	for name, value := range httpClientDetails.Headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := tc.targetClient.Client.Do(req)
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return errorutils.CheckError(errorutils.CheckResponseStatus(resp, http.StatusOK, http.StatusCreated))
+}
+
+// Returns true if the artifact already exists in the target path, with the same checksum.
+// This allows resuming an interrupted transfer, by running the same command again.
+func (tc *TransferCommand) isTransferred(item serviceutils.ResultItem, targetPath string) (bool, error) {
+	storageUrl, err := serviceutils.BuildArtifactoryUrl(tc.targetAuth.GetUrl(), "api/storage/"+targetPath, make(map[string]string))
+	if err != nil {
+		return false, err
+	}
+	resp, body, _, err := tc.targetClient.SendGet(storageUrl, true, tc.targetAuth.CreateHttpClientDetails())
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, errorutils.CheckError(errors.New("Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+	}
+	fileInfo := &struct {
+		Checksums fileutils.ChecksumDetails `json:"checksums,omitempty"`
+	}{}
+	if err = json.Unmarshal(body, fileInfo); err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	return fileInfo.Checksums.Sha1 == item.Actual_Sha1, nil
+}
+
+// Tries to deploy the artifact to the target server by checksum, without sending its content.
+// Returns false if the target server does not have the binary.
+func (tc *TransferCommand) checksumDeploy(item serviceutils.ResultItem, targetUrl string) (bool, error) {
+	httpClientDetails := tc.createTargetHttpClientDetails(item)
+	serviceutils.AddHeader("X-Checksum-Deploy", "true", &httpClientDetails.Headers)
+	resp, body, err := tc.targetClient.SendPut(targetUrl, nil, httpClientDetails)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusOK || resp.StatusCode == http.StatusCreated {
+		return true, nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return false, nil
+	}
+	return false, errorutils.CheckError(errors.New("Artifactory response: " + resp.Status + "\n" + clientutils.IndentJson(body)))
+}
+
+func (tc *TransferCommand) createTargetHttpClientDetails(item serviceutils.ResultItem) httputils.HttpClientDetails {
+	httpClientDetails := tc.targetAuth.CreateHttpClientDetails()
+	serviceutils.AddHeader("X-Checksum-Sha1", item.Actual_Sha1, &httpClientDetails.Headers)
+	serviceutils.AddHeader("X-Checksum-Md5", item.Actual_Md5, &httpClientDetails.Headers)
+	return httpClientDetails
+}
+
+func setAuthentication(req *http.Request, httpClientDetails httputils.HttpClientDetails) {
+	if httpClientDetails.ApiKey != "" {
+		if httpClientDetails.User != "" {
+			req.SetBasicAuth(httpClientDetails.User, httpClientDetails.ApiKey)
+		} else {
+			req.Header.Set("X-JFrog-Art-Api", httpClientDetails.ApiKey)
+		}
+		return
+	}
+	if httpClientDetails.AccessToken != "" {
+		if httpClientDetails.User != "" {
+			req.SetBasicAuth(httpClientDetails.User, httpClientDetails.AccessToken)
+		} else {
+			req.Header.Set("Authorization", "Bearer "+httpClientDetails.AccessToken)
+		}
+		return
+	}
+	if httpClientDetails.Password != "" {
+		req.SetBasicAuth(httpClientDetails.User, httpClientDetails.Password)
+	}
+}
+
+// Returns the target path of the artifact, using the same rules as the copy and move commands.
+func getMoveCopyTargetPath(file *spec.File, flat bool, item serviceutils.ResultItem) (string, error) {
+	target := file.Target
+	if !flat {
+		if strings.Contains(target, "/") {
+			fileName, dir := fileutils.GetFileAndDirFromPath(target)
+			target = clientutils.TrimPath(dir + "/" + item.Path + "/" + fileName)
+		} else {
+			target = clientutils.TrimPath(target + "/" + item.Path + "/")
+		}
+	}
+	targetPath, err := clientutils.BuildTargetPath(file.Pattern, item.GetItemRelativePath(), target, true)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasSuffix(targetPath, "/") {
+		targetPath += item.Name
+	}
+	return targetPath, nil
+}
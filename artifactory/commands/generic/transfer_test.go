@@ -0,0 +1,56 @@
+package generic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+)
+
+func TestGetTransferTargetPath(t *testing.T) {
+	item := serviceutils.ResultItem{Repo: "source-repo", Path: "a/b", Name: "file.zip", Type: "file"}
+	tests := []struct {
+		pattern  string
+		target   string
+		flat     bool
+		expected string
+	}{
+		{"source-repo/a/*", "target-repo/", true, "target-repo/file.zip"},
+		{"source-repo/a/*", "target-repo/", false, "target-repo/a/b/file.zip"},
+		{"source-repo/a/*", "target-repo/renamed.zip", true, "target-repo/renamed.zip"},
+		{"source-repo/(*)/b/*", "target-repo/{1}/", true, "target-repo/a/file.zip"},
+	}
+	for _, test := range tests {
+		file := &spec.File{Pattern: test.pattern, Target: test.target}
+		actual, err := getMoveCopyTargetPath(file, test.flat, item)
+		if err != nil {
+			t.Error(err)
+		}
+		if actual != test.expected {
+			t.Errorf("Pattern '%s', target '%s', flat %t: expected '%s', got '%s'.", test.pattern, test.target, test.flat, test.expected, actual)
+		}
+	}
+}
+
+func TestTransferSearchFailure(t *testing.T) {
+	log.SetDefaultLogger()
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"errors":[{"status":401,"message":"Bad credentials"}]}`)
+	}))
+	defer ts.Close()
+	transferCommand := NewTransferCommand().SetTargetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"})
+	transferCommand.SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"}).
+		SetSpec(&spec.SpecFiles{Files: []spec.File{{Pattern: "source-repo/*", Target: "target-repo/"}}})
+	if err := transferCommand.Run(); err == nil {
+		t.Error("Expected the search failure to be returned.")
+	}
+	if transferCommand.Result().SuccessCount() != 0 || transferCommand.Result().FailCount() != 0 {
+		t.Error("Expected no transferred artifacts, got", transferCommand.Result().SuccessCount(), "succeeded and", transferCommand.Result().FailCount(), "failed.")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
 	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/crypto/openpgp"
 	"os"
 	"strconv"
 	"strings"
@@ -23,6 +24,11 @@ type UploadCommand struct {
 	uploadConfiguration *utils.UploadConfiguration
 	buildConfiguration  *utils.BuildConfiguration
 	logFile             *os.File
+	ignoredPaths        []string
+	watchInterval       time.Duration
+	// The key used for signing the uploaded artifacts, and the properties of the uploaded signature and checksum files.
+	signer       *openpgp.Entity
+	sidecarProps string
 }
 
 func NewUploadCommand() *UploadCommand {
@@ -33,6 +39,22 @@ func (uc *UploadCommand) LogFile() *os.File {
 	return uc.logFile
 }
 
+// The local paths which were skipped, because they were matched by .gitignore or .jfrogignore files.
+func (uc *UploadCommand) IgnoredPaths() []string {
+	return uc.ignoredPaths
+}
+
+func (uc *UploadCommand) WatchInterval() time.Duration {
+	return uc.watchInterval
+}
+
+// If set, the command keeps running after the upload, and uploads the files matched by the spec as they are created or modified.
+// The files are scanned for changes at the specified interval.
+func (uc *UploadCommand) SetWatchInterval(watchInterval time.Duration) *UploadCommand {
+	uc.watchInterval = watchInterval
+	return uc
+}
+
 func (uc *UploadCommand) SetBuildConfiguration(buildConfiguration *utils.BuildConfiguration) *UploadCommand {
 	uc.buildConfiguration = buildConfiguration
 	return uc
@@ -67,15 +89,18 @@ func (uc *UploadCommand) upload() error {
 		timestamp := strconv.FormatInt(time.Now().UnixNano()/int64(time.Millisecond), 10)
 		syncDeletesProp = ";sync.deletes.timestamp=" + timestamp
 	}
-	// Initialize Progress bar, set logger to a log file
+	// Initialize Progress bar, set logger to a log file.
+	// The progress bar is not used in watch mode, which keeps running and logs a rolling summary instead.
 	var err error
 	var progressBar ioUtils.Progress
-	progressBar, uc.logFile, err = progressbar.InitProgressBarIfPossible()
-	if err != nil {
-		return err
-	}
-	if progressBar != nil {
-		defer progressBar.Quit()
+	if uc.watchInterval == 0 {
+		progressBar, uc.logFile, err = progressbar.InitProgressBarIfPossible()
+		if err != nil {
+			return err
+		}
+		if progressBar != nil {
+			defer progressBar.Quit()
+		}
 	}
 
 	// Create Service Manager:
@@ -95,6 +120,14 @@ func (uc *UploadCommand) upload() error {
 	if err != nil {
 		return err
 	}
+	// The signing key is loaded before the upload, so that a wrong keyring or passphrase fails the command before uploading.
+	if uc.uploadConfiguration.SigningKeyring != "" {
+		uc.signer, err = utils.LoadSigningKey(uc.uploadConfiguration.SigningKeyring, uc.uploadConfiguration.SigningKeyId)
+		if err != nil {
+			return err
+		}
+	}
+	uc.sidecarProps = strings.TrimPrefix(syncDeletesProp, ";")
 
 	// Build Info Collection:
 	isCollectBuildInfo := len(uc.buildConfiguration.BuildName) > 0 && len(uc.buildConfiguration.BuildNumber) > 0
@@ -105,6 +138,9 @@ func (uc *UploadCommand) upload() error {
 		for i := 0; i < len(uc.Spec().Files); i++ {
 			addBuildProps(&uc.Spec().Get(i).Props, uc.buildConfiguration.BuildName, uc.buildConfiguration.BuildNumber)
 		}
+		if err = addBuildProps(&uc.sidecarProps, uc.buildConfiguration.BuildName, uc.buildConfiguration.BuildNumber); err != nil {
+			return err
+		}
 	}
 
 	var errorOccurred = false
@@ -113,6 +149,11 @@ func (uc *UploadCommand) upload() error {
 	for i := 0; i < len(uc.Spec().Files); i++ {
 		file := uc.Spec().Get(i)
 		file.Props += syncDeletesProp
+		if err := uc.excludeIgnoredPaths(file); err != nil {
+			errorOccurred = true
+			log.Error(err)
+			continue
+		}
 		uploadParams, err := getUploadParams(file, uc.uploadConfiguration)
 		if err != nil {
 			errorOccurred = true
@@ -121,6 +162,18 @@ func (uc *UploadCommand) upload() error {
 		}
 		uploadParamsArray = append(uploadParamsArray, uploadParams)
 	}
+	if len(uc.ignoredPaths) > 0 {
+		log.Info("Skipped", len(uc.ignoredPaths), "paths matched by ignore files.")
+	}
+
+	// In watch mode, the files are scanned before the upload, so that files modified during the upload are uploaded again.
+	var watcher *uploadWatcher
+	if uc.watchInterval > 0 {
+		watcher = newUploadWatcher(uploadParamsArray, uc.uploadConfiguration.Symlink)
+		if err = watcher.scan(); err != nil {
+			return err
+		}
+	}
 
 	// Perform upload.
 	filesInfo, successCount, failCount, err := servicesManager.UploadFiles(uploadParamsArray...)
@@ -138,6 +191,12 @@ func (uc *UploadCommand) upload() error {
 	if failCount > 0 {
 		return err
 	}
+	// The signature and checksum files are uploaded before handling sync-deletes, so that they are not deleted.
+	sidecarsInfo, err := uc.uploadSidecarFiles(filesInfo, servicesManager)
+	if err != nil {
+		return err
+	}
+	filesInfo = append(filesInfo, sidecarsInfo...)
 
 	if !uc.DryRun() {
 		// Handle sync-deletes
@@ -149,17 +208,48 @@ func (uc *UploadCommand) upload() error {
 		}
 		// Build Info
 		if isCollectBuildInfo {
-			buildArtifacts := convertFileInfoToBuildArtifacts(filesInfo)
-			populateFunc := func(partial *buildinfo.Partial) {
-				partial.Artifacts = buildArtifacts
-				partial.ModuleId = uc.buildConfiguration.Module
+			if err = uc.saveBuildArtifacts(filesInfo); err != nil {
+				return err
 			}
-			err = utils.SavePartialBuildInfo(uc.buildConfiguration.BuildName, uc.buildConfiguration.BuildNumber, populateFunc)
 		}
 	}
+	if watcher != nil {
+		return uc.watch(watcher, servicesManager, isCollectBuildInfo)
+	}
 	return err
 }
 
+// If the file group respects the ignore files, adds the paths matched by the ignore files to its exclusions.
+func (uc *UploadCommand) excludeIgnoredPaths(file *spec.File) error {
+	respectIgnoreFiles, err := file.IsRespectIgnoreFiles(false)
+	if err != nil || !respectIgnoreFiles {
+		return err
+	}
+	isRegexp, err := file.IsRegexp(false)
+	if err != nil {
+		return err
+	}
+	ignoredPaths, err := getIgnoredPaths(file, uc.uploadConfiguration.Symlink)
+	if err != nil {
+		return err
+	}
+	for _, path := range ignoredPaths {
+		log.Info("Skipping", path, "- matched by an ignore file.")
+		file.ExcludePatterns = append(file.ExcludePatterns, createIgnoredPathExclusions(path, isRegexp)...)
+	}
+	uc.ignoredPaths = append(uc.ignoredPaths, ignoredPaths...)
+	return nil
+}
+
+func (uc *UploadCommand) saveBuildArtifacts(filesInfo []clientutils.FileInfo) error {
+	buildArtifacts := convertFileInfoToBuildArtifacts(filesInfo)
+	populateFunc := func(partial *buildinfo.Partial) {
+		partial.Artifacts = buildArtifacts
+		partial.ModuleId = uc.buildConfiguration.Module
+	}
+	return utils.SavePartialBuildInfo(uc.buildConfiguration.BuildName, uc.buildConfiguration.BuildNumber, populateFunc)
+}
+
 func convertFileInfoToBuildArtifacts(filesInfo []clientutils.FileInfo) []buildinfo.Artifact {
 	buildArtifacts := make([]buildinfo.Artifact, len(filesInfo))
 	for i, fileInfo := range filesInfo {
@@ -0,0 +1,173 @@
+package generic
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	clientutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+	"golang.org/x/crypto/openpgp"
+)
+
+// The checksum files which can be uploaded next to each artifact. The extension of each file is the name of its algorithm.
+const (
+	ChecksumFileSha256 = "sha256"
+	ChecksumFileMd5    = "md5"
+)
+
+func ValidateChecksumFiles(checksumFiles []string) error {
+	for _, checksumFile := range checksumFiles {
+		if checksumFile != ChecksumFileSha256 && checksumFile != ChecksumFileMd5 {
+			return errorutils.CheckError(errors.New("The checksum file type '" + checksumFile + "' is invalid. Possible values are: " + ChecksumFileSha256 + ", " + ChecksumFileMd5 + "."))
+		}
+	}
+	return nil
+}
+
+// A signature or checksum file, generated locally and uploaded next to an artifact.
+type sidecarFile struct {
+	localPath  string
+	targetPath string
+}
+
+func (uc *UploadCommand) hasSidecarFiles() bool {
+	return uc.signer != nil || len(uc.uploadConfiguration.ChecksumFiles) > 0
+}
+
+// Returns the extensions of the sidecar files uploaded next to each artifact.
+func (uc *UploadCommand) sidecarExtensions() []string {
+	var extensions []string
+	if uc.signer != nil {
+		extensions = append(extensions, utils.PgpSignatureExtension)
+	}
+	for _, checksumFile := range uc.uploadConfiguration.ChecksumFiles {
+		extensions = append(extensions, "."+checksumFile)
+	}
+	return extensions
+}
+
+// Generates the signature and checksum files of the uploaded artifacts, and uploads them next to the artifacts.
+// Returns the details of the uploaded sidecar files, so that they are recorded in the build-info as artifacts too.
+func (uc *UploadCommand) uploadSidecarFiles(filesInfo []clientutils.FileInfo, servicesManager *artifactory.ArtifactoryServicesManager) ([]clientutils.FileInfo, error) {
+	if !uc.hasSidecarFiles() || len(filesInfo) == 0 {
+		return nil, nil
+	}
+	tempDir, err := ioutil.TempDir("", "jfrog.cli.sidecars.")
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	artifactoryUrl := servicesManager.GetConfig().GetArtDetails().GetUrl()
+	var uploadParamsArray []services.UploadParams
+	for i, fileInfo := range filesInfo {
+		targetPath, err := url.PathUnescape(strings.TrimPrefix(fileInfo.ArtifactoryPath, artifactoryUrl))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		// Each artifact has its own directory, since artifacts in different paths may have the same name.
+		sidecarsDir := filepath.Join(tempDir, strconv.Itoa(i))
+		if err = os.Mkdir(sidecarsDir, 0700); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		sidecars, err := createSidecarFiles(fileInfo.LocalPath, targetPath, sidecarsDir, uc.signer, uc.uploadConfiguration.ChecksumFiles)
+		if err != nil {
+			return nil, err
+		}
+		for _, sidecar := range sidecars {
+			uploadParamsArray = append(uploadParamsArray, uc.getSidecarUploadParams(sidecar))
+		}
+	}
+	log.Info("Uploading", len(uploadParamsArray), "signature and checksum files...")
+	sidecarsInfo, _, failCount, err := servicesManager.UploadFiles(uploadParamsArray...)
+	if err != nil {
+		return nil, err
+	}
+	if failCount > 0 {
+		return nil, errorutils.CheckError(errors.New("Failed uploading " + strconv.Itoa(failCount) + " signature and checksum files."))
+	}
+	return sidecarsInfo, nil
+}
+
+func (uc *UploadCommand) getSidecarUploadParams(sidecar sidecarFile) services.UploadParams {
+	uploadParams := services.NewUploadParams()
+	uploadParams.ArtifactoryCommonParams = &clientutils.ArtifactoryCommonParams{Pattern: sidecar.localPath, Target: sidecar.targetPath, Props: uc.sidecarProps}
+	uploadParams.Flat = true
+	uploadParams.Retries = uc.uploadConfiguration.Retries
+	uploadParams.MinChecksumDeploy = uc.uploadConfiguration.MinChecksumDeploySize
+	return uploadParams
+}
+
+// Creates the signature and checksum files of a local artifact in the specified directory.
+// The checksum files use the format of the sha256sum and md5sum tools, so that they can be verified using these tools.
+func createSidecarFiles(localPath, targetPath, dir string, signer *openpgp.Entity, checksumFiles []string) ([]sidecarFile, error) {
+	artifactName := path.Base(targetPath)
+	var sidecars []sidecarFile
+	if signer != nil {
+		sidecar := sidecarFile{localPath: filepath.Join(dir, artifactName+utils.PgpSignatureExtension), targetPath: targetPath + utils.PgpSignatureExtension}
+		if err := writeSignatureFile(localPath, sidecar.localPath, signer); err != nil {
+			return nil, err
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	for _, checksumFile := range checksumFiles {
+		checksum, err := calcFileChecksum(localPath, checksumFile)
+		if err != nil {
+			return nil, err
+		}
+		extension := "." + checksumFile
+		sidecar := sidecarFile{localPath: filepath.Join(dir, artifactName+extension), targetPath: targetPath + extension}
+		if err = ioutil.WriteFile(sidecar.localPath, []byte(checksum+"  "+artifactName+"\n"), 0600); err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		sidecars = append(sidecars, sidecar)
+	}
+	return sidecars, nil
+}
+
+func writeSignatureFile(localPath, signaturePath string, signer *openpgp.Entity) error {
+	content, err := os.Open(localPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer content.Close()
+	signature, err := os.Create(signaturePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer signature.Close()
+	return utils.SignDetached(signer, content, signature)
+}
+
+func calcFileChecksum(localPath, algorithm string) (string, error) {
+	var checksumHash hash.Hash
+	if algorithm == ChecksumFileSha256 {
+		checksumHash = sha256.New()
+	} else {
+		checksumHash = md5.New()
+	}
+	file, err := os.Open(localPath)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	defer file.Close()
+	if _, err = io.Copy(checksumHash, file); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return hex.EncodeToString(checksumHash.Sum(nil)), nil
+}
@@ -0,0 +1,81 @@
+package generic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+func TestCreateSidecarFiles(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "upload-sidecars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	localPath := filepath.Join(tempDir, "local.txt")
+	if err = ioutil.WriteFile(localPath, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sidecarsDir := filepath.Join(tempDir, "sidecars")
+	if err = os.Mkdir(sidecarsDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	signer, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sidecars, err := createSidecarFiles(localPath, "repo/a/artifact.txt", sidecarsDir, signer, []string{ChecksumFileSha256, ChecksumFileMd5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedTargets := []string{"repo/a/artifact.txt.asc", "repo/a/artifact.txt.sha256", "repo/a/artifact.txt.md5"}
+	if len(sidecars) != len(expectedTargets) {
+		t.Fatalf("Expected %d sidecar files, got %d.", len(expectedTargets), len(sidecars))
+	}
+	for i, sidecar := range sidecars {
+		if sidecar.targetPath != expectedTargets[i] {
+			t.Errorf("Expected target %s, got %s.", expectedTargets[i], sidecar.targetPath)
+		}
+	}
+
+	expectedChecksums := []string{
+		"ed7002b439e9ac845f22357d822bac1444730fbdb6016d3ec9432297b9ec9f73  artifact.txt\n",
+		"9a0364b9e99bb480dd25e1f0284c8555  artifact.txt\n",
+	}
+	for i, expected := range expectedChecksums {
+		content, err := ioutil.ReadFile(sidecars[i+1].localPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != expected {
+			t.Errorf("Expected checksum file content %q, got %q.", expected, content)
+		}
+	}
+
+	signature, err := os.Open(sidecars[0].localPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer signature.Close()
+	content, err := os.Open(localPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+	if _, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{signer}, content, signature); err != nil {
+		t.Errorf("Expected a valid signature: %v", err)
+	}
+}
+
+func TestValidateChecksumFiles(t *testing.T) {
+	if err := ValidateChecksumFiles([]string{ChecksumFileSha256, ChecksumFileMd5}); err != nil {
+		t.Error(err)
+	}
+	if err := ValidateChecksumFiles([]string{"sha1"}); err == nil {
+		t.Error("Expected an error for an unsupported checksum file.")
+	}
+}
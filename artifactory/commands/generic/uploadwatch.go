@@ -0,0 +1,272 @@
+package generic
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	"github.com/jfrog/jfrog-client-go/artifactory/services/fspatterns"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The state of a local file matched by the upload spec, as seen by the last scan.
+type watchedFile struct {
+	modTime time.Time
+	size    int64
+	// The index of the spec file group which matched the file.
+	group  int
+	target string
+}
+
+func (wf watchedFile) isModified(other watchedFile) bool {
+	return !wf.modTime.Equal(other.modTime) || wf.size != other.size
+}
+
+// Detects created, modified and deleted files by periodically scanning the local paths matched by the upload spec.
+// To avoid uploading files which are still being written, a changed file is uploaded only after it remains unchanged for a full scan interval.
+type uploadWatcher struct {
+	uploadParams []services.UploadParams
+	symlink      bool
+	files        map[string]watchedFile
+	// Files which changed in the last scan, and are uploaded if they are unchanged in the next one.
+	pending map[string]bool
+}
+
+func newUploadWatcher(uploadParams []services.UploadParams, symlink bool) *uploadWatcher {
+	return &uploadWatcher{uploadParams: uploadParams, symlink: symlink, files: make(map[string]watchedFile), pending: make(map[string]bool)}
+}
+
+// Records the current state of the files, without returning changes.
+func (uw *uploadWatcher) scan() error {
+	_, _, err := uw.next()
+	return err
+}
+
+// Scans the files. Returns the files to upload, and the target paths of the files which were deleted since the previous scan.
+func (uw *uploadWatcher) next() (changed, deletedTargets []string, err error) {
+	current := make(map[string]watchedFile)
+	for i, params := range uw.uploadParams {
+		if err = scanUploadParams(i, params, uw.symlink, current); err != nil {
+			return nil, nil, err
+		}
+	}
+	changed, deleted := diffWatchedFiles(uw.files, current, uw.pending)
+	for _, path := range deleted {
+		deletedTargets = append(deletedTargets, uw.files[path].target)
+	}
+	uw.files = current
+	return
+}
+
+// Compares the files of the previous and current scans.
+// Returns the pending files which are unchanged since the previous scan, and the files which were deleted.
+// The pending files are updated with the files which were created or modified since the previous scan.
+func diffWatchedFiles(previous, current map[string]watchedFile, pending map[string]bool) (changed, deleted []string) {
+	for path, file := range current {
+		previousFile, exists := previous[path]
+		if !exists || previousFile.isModified(file) {
+			pending[path] = true
+			continue
+		}
+		if pending[path] {
+			changed = append(changed, path)
+			delete(pending, path)
+		}
+	}
+	for path := range previous {
+		if _, exists := current[path]; !exists {
+			deleted = append(deleted, path)
+			delete(pending, path)
+		}
+	}
+	sort.Strings(changed)
+	sort.Strings(deleted)
+	return
+}
+
+// Adds the files matched by the spec file group to the scanned files, using the same matching as the upload itself.
+// A file matched by several groups is uploaded according to the first of them.
+func scanUploadParams(group int, params services.UploadParams, symlink bool, files map[string]watchedFile) error {
+	rootPath, err := fspatterns.GetRootPath(clientutils.ReplaceTildeWithUserHome(params.GetPattern()), params.IsRegexp(), symlink)
+	if err != nil {
+		// The root path may be created later.
+		log.Debug(err.Error())
+		return nil
+	}
+	isDir, err := fileutils.IsDirExists(rootPath, symlink)
+	if err != nil {
+		return err
+	}
+	if !isDir {
+		return addWatchedFile(rootPath, group, getWatchedFileTarget(rootPath, params.GetTarget(), nil, params.IsFlat()), files)
+	}
+	patternRegex, err := regexp.Compile(clientutils.PrepareLocalPathForUpload(clientutils.ReplaceTildeWithUserHome(params.GetPattern()), params.IsRegexp()))
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	excludePathPattern := fspatterns.PrepareExcludePathPattern(params)
+	paths, err := fspatterns.GetPaths(rootPath, params.IsRecursive(), false, symlink)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		matches, isDir, _, err := fspatterns.PrepareAndFilterPaths(path, excludePathPattern, symlink, false, patternRegex)
+		if err != nil {
+			return err
+		}
+		if isDir || len(matches) == 0 {
+			continue
+		}
+		if err = addWatchedFile(path, group, getWatchedFileTarget(path, params.GetTarget(), matches, params.IsFlat()), files); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addWatchedFile(path string, group int, target string, files map[string]watchedFile) error {
+	if _, exists := files[path]; exists {
+		return nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		// The file may be deleted while scanning.
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errorutils.CheckError(err)
+	}
+	files[path] = watchedFile{modTime: info.ModTime(), size: info.Size(), group: group, target: target}
+	return nil
+}
+
+// Returns the target path of a local file, replacing the placeholders of the target with the groups matched by the pattern,
+// in the same way as the upload does.
+func getWatchedFileTarget(path, target string, matches []string, flat bool) string {
+	if !strings.Contains(target, "/") {
+		target += "/"
+	}
+	for i := 1; i < len(matches); i++ {
+		target = strings.Replace(target, "{"+strconv.Itoa(i)+"}", strings.Replace(matches[i], "\\", "/", -1), -1)
+	}
+	if !strings.HasSuffix(target, "/") {
+		return target
+	}
+	if flat {
+		fileName, _ := fileutils.GetFileAndDirFromPath(path)
+		return target + fileName
+	}
+	return target + clientutils.TrimPath(path)
+}
+
+// Keeps scanning the files matched by the spec, uploading created and modified files, until the command is interrupted.
+// If sync-deletes is set, the files deleted locally are also deleted from the sync-deletes path in Artifactory.
+func (uc *UploadCommand) watch(watcher *uploadWatcher, servicesManager *artifactory.ArtifactoryServicesManager, isCollectBuildInfo bool) error {
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(interrupted)
+	ticker := time.NewTicker(uc.watchInterval)
+	defer ticker.Stop()
+
+	log.Info("Watching for changes every", uc.watchInterval.String()+". Press Ctrl+C to stop.")
+	deletedCount := 0
+	for {
+		select {
+		case <-interrupted:
+			log.Info("Stopped watching.")
+			return nil
+		case <-ticker.C:
+		}
+		changed, deleted, err := watcher.next()
+		if err != nil {
+			return err
+		}
+		if len(changed) == 0 && len(deleted) == 0 {
+			continue
+		}
+		if len(changed) > 0 {
+			if err = uc.uploadWatchedFiles(watcher, changed, servicesManager, isCollectBuildInfo); err != nil {
+				log.Error(err)
+			}
+		}
+		deletedCount += uc.syncWatchedDeletes(deleted, servicesManager)
+		result := uc.Result()
+		log.Info(fmt.Sprintf("Watch summary: %d files uploaded, %d failed, %d deleted.", result.SuccessCount(), result.FailCount(), deletedCount))
+	}
+}
+
+// Uploads each of the files to its target path, with the other upload parameters of the spec file group which matched it.
+func (uc *UploadCommand) uploadWatchedFiles(watcher *uploadWatcher, paths []string, servicesManager *artifactory.ArtifactoryServicesManager, isCollectBuildInfo bool) error {
+	var uploadParamsArray []services.UploadParams
+	for _, path := range paths {
+		file := watcher.files[path]
+		log.Info("Uploading changed file", path, "to", file.target)
+		params := watcher.uploadParams[file.group]
+		commonParams := *params.ArtifactoryCommonParams
+		commonParams.Pattern = path
+		commonParams.Target = file.target
+		commonParams.ExcludePatterns = nil
+		params.ArtifactoryCommonParams = &commonParams
+		params.Regexp = false
+		uploadParamsArray = append(uploadParamsArray, params)
+	}
+	filesInfo, successCount, failCount, err := servicesManager.UploadFiles(uploadParamsArray...)
+	result := uc.Result()
+	result.SetSuccessCount(result.SuccessCount() + successCount)
+	result.SetFailCount(result.FailCount() + failCount)
+	if err != nil {
+		return err
+	}
+	sidecarsInfo, err := uc.uploadSidecarFiles(filesInfo, servicesManager)
+	if err != nil {
+		return err
+	}
+	filesInfo = append(filesInfo, sidecarsInfo...)
+	if isCollectBuildInfo && !uc.DryRun() {
+		return uc.saveBuildArtifacts(filesInfo)
+	}
+	return nil
+}
+
+// Deletes the targets of the deleted files which are under the sync-deletes path, with their signature and checksum files.
+// Returns the number of deleted targets.
+func (uc *UploadCommand) syncWatchedDeletes(deletedTargets []string, servicesManager *artifactory.ArtifactoryServicesManager) int {
+	syncDeletesPath := strings.TrimSuffix(uc.SyncDeletesPath(), "/")
+	if syncDeletesPath == "" {
+		return 0
+	}
+	deletedCount := 0
+	for _, target := range deletedTargets {
+		if !strings.HasPrefix(target, syncDeletesPath+"/") {
+			continue
+		}
+		if uc.DryRun() {
+			log.Info("[Dry run] Deleting", target)
+			deletedCount++
+			continue
+		}
+		log.Info("Deleting", target)
+		if err := deleteItem(target, servicesManager); err != nil {
+			log.Error(err)
+			continue
+		}
+		for _, extension := range uc.sidecarExtensions() {
+			if err := deleteItem(target+extension, servicesManager); err != nil {
+				log.Error(err)
+			}
+		}
+		deletedCount++
+	}
+	return deletedCount
+}
@@ -0,0 +1,98 @@
+package generic
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+)
+
+func TestDiffWatchedFiles(t *testing.T) {
+	now := time.Now()
+	pending := make(map[string]bool)
+	scans := []struct {
+		files           map[string]watchedFile
+		expectedChanged []string
+		expectedDeleted []string
+	}{
+		{map[string]watchedFile{"a": {modTime: now, size: 1}, "b": {modTime: now, size: 1}}, nil, nil},
+		// 'a' is still being written, 'c' is created and 'b' is deleted.
+		{map[string]watchedFile{"a": {modTime: now.Add(time.Second), size: 2}, "c": {modTime: now, size: 1}}, nil, []string{"b"}},
+		// 'c' is unchanged for a full interval, while 'a' is still being written.
+		{map[string]watchedFile{"a": {modTime: now.Add(2 * time.Second), size: 3}, "c": {modTime: now, size: 1}}, []string{"c"}, nil},
+		{map[string]watchedFile{"a": {modTime: now.Add(2 * time.Second), size: 3}, "c": {modTime: now, size: 1}}, []string{"a"}, nil},
+		{map[string]watchedFile{"a": {modTime: now.Add(2 * time.Second), size: 3}, "c": {modTime: now, size: 1}}, nil, nil},
+	}
+	previous := make(map[string]watchedFile)
+	for i, scan := range scans {
+		changed, deleted := diffWatchedFiles(previous, scan.files, pending)
+		if !reflect.DeepEqual(scan.expectedChanged, changed) || !reflect.DeepEqual(scan.expectedDeleted, deleted) {
+			t.Errorf("Scan %d: expected %v changed and %v deleted, got %v and %v.", i, scan.expectedChanged, scan.expectedDeleted, changed, deleted)
+		}
+		previous = scan.files
+	}
+}
+
+func TestGetWatchedFileTarget(t *testing.T) {
+	path := filepath.Join("out", "a", "b.txt")
+	tests := []struct {
+		target   string
+		matches  []string
+		flat     bool
+		expected string
+	}{
+		{"repo", nil, true, "repo/b.txt"},
+		{"repo/dir/", nil, true, "repo/dir/b.txt"},
+		{"repo/dir/", nil, false, "repo/dir/out/a/b.txt"},
+		{"repo/{1}/file.txt", []string{path, "a"}, true, "repo/a/file.txt"},
+		{"repo/{1}/", []string{path, "a"}, true, "repo/a/b.txt"},
+	}
+	for _, test := range tests {
+		if actual := getWatchedFileTarget(path, test.target, test.matches, test.flat); actual != test.expected {
+			t.Errorf("Target '%s': expected %s, got %s.", test.target, test.expected, actual)
+		}
+	}
+}
+
+func TestScanUploadParams(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "upload-watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	for _, name := range []string{"a.txt", "b.log", filepath.Join("sub", "c.txt")} {
+		path := filepath.Join(tempDir, name)
+		if err = os.MkdirAll(filepath.Dir(path), 0777); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	uploadSpec := spec.NewBuilder().Pattern(tempDir + "/(*).txt").Target("repo/{1}.bin").Recursive(true).Flat(true).BuildSpec()
+	uploadParams, err := getUploadParams(uploadSpec.Get(0), &utils.UploadConfiguration{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make(map[string]watchedFile)
+	if err = scanUploadParams(0, uploadParams, false, files); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{
+		filepath.Join(tempDir, "a.txt"):        "repo/a.bin",
+		filepath.Join(tempDir, "sub", "c.txt"): "repo/sub/c.bin",
+	}
+	if len(files) != len(expected) {
+		t.Fatalf("Expected %d files, got %v.", len(expected), files)
+	}
+	for path, target := range expected {
+		if files[path].target != target || files[path].size == 0 {
+			t.Errorf("Expected %s to be uploaded to %s, got %v.", path, target, files[path])
+		}
+	}
+}
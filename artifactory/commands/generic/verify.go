@@ -0,0 +1,461 @@
+package generic
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+	"github.com/jfrog/jfrog-client-go/artifactory/services"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const (
+	// A file which exists in Artifactory, or in the build, but not locally.
+	VerifyMissing = "missing"
+	// A local file which does not exist in Artifactory, or in the build.
+	VerifyExtra = "extra"
+	// A local file whose checksum is different from the checksum in Artifactory, or in the build.
+	VerifyCorrupted = "corrupted"
+)
+
+type VerifyResult struct {
+	Path           string `json:"path,omitempty"`
+	Status         string `json:"status,omitempty"`
+	ExpectedSha1   string `json:"expectedSha1,omitempty"`
+	ActualSha1     string `json:"actualSha1,omitempty"`
+	ExpectedSha256 string `json:"expectedSha256,omitempty"`
+	ActualSha256   string `json:"actualSha256,omitempty"`
+}
+
+// The checksums a local file is expected to have. Sha256 may be empty, if Artifactory did not calculate it.
+type expectedChecksums struct {
+	Sha1   string
+	Sha256 string
+}
+
+// A file expected by a build, as an artifact or as a dependency.
+type buildFile struct {
+	Name string
+	Sha1 string
+}
+
+// Verifies local files against the checksums in Artifactory.
+// The expected files are taken from one of the following:
+// 1. A repository path, compared with a local directory.
+// 2. A download File Spec, compared with the local files the spec downloads.
+// 3. The artifacts and dependencies of a published build, compared with a local directory.
+type VerifyCommand struct {
+	GenericCommand
+	localPath    string
+	repoPath     string
+	recursive    bool
+	buildName    string
+	buildNumber  string
+	verifyResult []VerifyResult
+}
+
+func NewVerifyCommand() *VerifyCommand {
+	return &VerifyCommand{GenericCommand: *NewGenericCommand(), recursive: true}
+}
+
+func (vc *VerifyCommand) LocalPath() string {
+	return vc.localPath
+}
+
+func (vc *VerifyCommand) SetLocalPath(localPath string) *VerifyCommand {
+	vc.localPath = localPath
+	return vc
+}
+
+func (vc *VerifyCommand) RepoPath() string {
+	return vc.repoPath
+}
+
+func (vc *VerifyCommand) SetRepoPath(repoPath string) *VerifyCommand {
+	vc.repoPath = repoPath
+	return vc
+}
+
+func (vc *VerifyCommand) Recursive() bool {
+	return vc.recursive
+}
+
+func (vc *VerifyCommand) SetRecursive(recursive bool) *VerifyCommand {
+	vc.recursive = recursive
+	return vc
+}
+
+func (vc *VerifyCommand) SetBuild(buildName, buildNumber string) *VerifyCommand {
+	vc.buildName = buildName
+	vc.buildNumber = buildNumber
+	return vc
+}
+
+func (vc *VerifyCommand) VerifyResult() []VerifyResult {
+	return vc.verifyResult
+}
+
+func (vc *VerifyCommand) CommandName() string {
+	return "rt_verify"
+}
+
+func (vc *VerifyCommand) Run() error {
+	rtDetails, err := vc.RtDetails()
+	if errorutils.CheckError(err) != nil {
+		return err
+	}
+	servicesManager, err := utils.CreateServiceManager(rtDetails, false)
+	if err != nil {
+		return err
+	}
+	switch {
+	case vc.spec != nil:
+		err = vc.verifySpec(servicesManager)
+	case vc.buildName != "":
+		err = vc.verifyBuild(servicesManager)
+	default:
+		err = vc.verifyRepoPath(servicesManager)
+	}
+	if err != nil {
+		return err
+	}
+	log.Info("Found", len(vc.verifyResult), "missing, extra or corrupted files.")
+	return nil
+}
+
+func (vc *VerifyCommand) verifyRepoPath(servicesManager *artifactory.ArtifactoryServicesManager) error {
+	localChecksums, err := getLocalChecksums(vc.localPath, vc.recursive)
+	if err != nil {
+		return err
+	}
+	log.Info("Searching artifacts...")
+	repoPath := strings.TrimSuffix(vc.repoPath, "/")
+	query := createItemsAql(createRepoPathAqlCriteria(repoPath, "file", vc.recursive), []string{"repo", "path", "name", "actual_sha1", "sha256"})
+	log.Debug("Searching Artifactory using AQL query:", query)
+	content, err := servicesManager.Aql(query)
+	if err != nil {
+		return err
+	}
+	expected, err := parseVerifyAqlResult(content, repoPath)
+	if err != nil {
+		return err
+	}
+	vc.verifyResult = compareWithExpected(localChecksums, expected, true)
+	return nil
+}
+
+// Returns AQL criteria matching the items of the specified type under the <repository name>/<repository path> path.
+func createRepoPathAqlCriteria(repoPath, itemType string, recursive bool) []string {
+	repo, dir := repoPath, "."
+	if i := strings.Index(repoPath, "/"); i >= 0 {
+		repo, dir = repoPath[:i], repoPath[i+1:]
+	}
+	criteria := []string{`{"repo":` + quoteAql(repo) + `}`, `{"type":` + quoteAql(itemType) + `}`}
+	switch {
+	case !recursive:
+		criteria = append(criteria, `{"path":`+quoteAql(dir)+`}`)
+	case dir != ".":
+		criteria = append(criteria, `{"$or":[{"path":`+quoteAql(dir)+`},{"path":{"$match":`+quoteAql(dir+"/*")+`}}]}`)
+	}
+	return criteria
+}
+
+// Returns the expected checksums of the AQL result items, mapped by their path relative to the repository path.
+func parseVerifyAqlResult(content []byte, repoPath string) (map[string]expectedChecksums, error) {
+	result := &struct {
+		Results []struct {
+			Repo   string `json:"repo"`
+			Path   string `json:"path"`
+			Name   string `json:"name"`
+			Sha1   string `json:"actual_sha1"`
+			Sha256 string `json:"sha256"`
+		} `json:"results"`
+	}{}
+	if err := json.Unmarshal(content, result); err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	expected := make(map[string]expectedChecksums, len(result.Results))
+	for _, item := range result.Results {
+		itemPath := path.Join(item.Repo, item.Path, item.Name)
+		expected[strings.TrimPrefix(itemPath, repoPath+"/")] = expectedChecksums{Sha1: item.Sha1, Sha256: item.Sha256}
+	}
+	return expected, nil
+}
+
+// Verifies the local files which the spec downloads. Files which are not matched by the spec are not reported.
+func (vc *VerifyCommand) verifySpec(servicesManager *artifactory.ArtifactoryServicesManager) error {
+	expected := make(map[string]expectedChecksums)
+	// The repository paths of the expected files, mapped by their local paths.
+	repoPaths := make(map[string]string)
+	var allResultItems []serviceutils.ResultItem
+	for i := 0; i < len(vc.spec.Files); i++ {
+		file := vc.spec.Get(i)
+		searchParams, err := GetSearchParams(file)
+		if err != nil {
+			return err
+		}
+		searchParams.IncludeDirs = false
+		flat, err := file.IsFlat(false)
+		if err != nil {
+			return err
+		}
+		resultItems, err := servicesManager.SearchFiles(searchParams)
+		if err != nil {
+			return err
+		}
+		for _, item := range resultItems {
+			localPath, err := getDownloadLocalPath(file, flat, item)
+			if err != nil {
+				return err
+			}
+			expected[localPath] = expectedChecksums{Sha1: item.Actual_Sha1}
+			repoPaths[localPath] = item.GetItemRelativePath()
+		}
+		allResultItems = append(allResultItems, resultItems...)
+	}
+	// Like the other modes, the files are verified by their SHA-256 checksums too, which the search results do not include.
+	sha256Checksums, err := getItemsSha256(allResultItems, servicesManager)
+	if err != nil {
+		return err
+	}
+	for localPath, expectedFile := range expected {
+		expectedFile.Sha256 = sha256Checksums[repoPaths[localPath]]
+		expected[localPath] = expectedFile
+	}
+	localChecksums := make(map[string]fileutils.ChecksumDetails)
+	for localPath := range expected {
+		details, err := fileutils.GetFileDetails(localPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if errorutils.CheckError(err) != nil {
+			return err
+		}
+		localChecksums[localPath] = details.Checksum
+	}
+	vc.verifyResult = compareWithExpected(localChecksums, expected, false)
+	return nil
+}
+
+// The maximal number of items whose SHA-256 checksums are fetched by a single AQL query.
+const sha256AqlBatchSize = 100
+
+// Returns the SHA-256 checksums of the items, mapped by their repository paths.
+// Items without a SHA-256 checksum, which Artifactory did not calculate, are not included.
+func getItemsSha256(resultItems []serviceutils.ResultItem, servicesManager *artifactory.ArtifactoryServicesManager) (map[string]string, error) {
+	checksums := make(map[string]string, len(resultItems))
+	for start := 0; start < len(resultItems); start += sha256AqlBatchSize {
+		end := start + sha256AqlBatchSize
+		if end > len(resultItems) {
+			end = len(resultItems)
+		}
+		itemsCriteria := make([]string, 0, end-start)
+		for _, item := range resultItems[start:end] {
+			itemsCriteria = append(itemsCriteria, `{"repo":`+quoteAql(item.Repo)+`,"path":`+quoteAql(item.Path)+`,"name":`+quoteAql(item.Name)+`}`)
+		}
+		query := createItemsAql([]string{`{"$or":[` + strings.Join(itemsCriteria, ",") + `]}`}, []string{"repo", "path", "name", "actual_sha1", "sha256"})
+		log.Debug("Searching Artifactory using AQL query:", query)
+		content, err := servicesManager.Aql(query)
+		if err != nil {
+			return nil, err
+		}
+		batchChecksums, err := parseVerifyAqlResult(content, "")
+		if err != nil {
+			return nil, err
+		}
+		for repoPath, itemChecksums := range batchChecksums {
+			if itemChecksums.Sha256 != "" {
+				checksums[repoPath] = itemChecksums.Sha256
+			}
+		}
+	}
+	return checksums, nil
+}
+
+// Returns the local path into which the download command downloads the item.
+func getDownloadLocalPath(file *spec.File, flat bool, item serviceutils.ResultItem) (string, error) {
+	target, err := clientutils.BuildTargetPath(file.Pattern, item.GetItemRelativePath(), file.Target, true)
+	if err != nil {
+		return "", err
+	}
+	localPath, localFileName := fileutils.GetLocalPathAndFile(item.Name, item.Path, target, flat)
+	return filepath.Join(localPath, localFileName), nil
+}
+
+func (vc *VerifyCommand) verifyBuild(servicesManager *artifactory.ArtifactoryServicesManager) error {
+	localChecksums, err := getLocalChecksums(vc.localPath, vc.recursive)
+	if err != nil {
+		return err
+	}
+	buildInfoParams := services.NewBuildInfoParams()
+	buildInfoParams.BuildName = vc.buildName
+	buildInfoParams.BuildNumber = vc.buildNumber
+	build, err := servicesManager.GetBuildInfo(buildInfoParams)
+	if err != nil {
+		return err
+	}
+	if build.Name == "" {
+		return errorutils.CheckError(errors.New("Build " + vc.buildName + "/" + vc.buildNumber + " was not found in Artifactory."))
+	}
+	vc.verifyResult = compareWithBuild(localChecksums, getBuildFiles(build))
+	return nil
+}
+
+func getBuildFiles(build *buildinfo.BuildInfo) []buildFile {
+	var files []buildFile
+	for _, module := range build.Modules {
+		for _, artifact := range module.Artifacts {
+			if artifact.Checksum != nil {
+				files = append(files, buildFile{Name: artifact.Name, Sha1: artifact.Sha1})
+			}
+		}
+		for _, dependency := range module.Dependencies {
+			if dependency.Checksum != nil {
+				files = append(files, buildFile{Name: dependency.Id, Sha1: dependency.Sha1})
+			}
+		}
+	}
+	return files
+}
+
+// Returns the checksums of the local files, mapped by their path relative to the local path.
+func getLocalChecksums(localPath string, recursive bool) (map[string]fileutils.ChecksumDetails, error) {
+	log.Info("Calculating local checksums...")
+	root := filepath.Clean(localPath)
+	info, err := os.Stat(root)
+	if errorutils.CheckError(err) != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errorutils.CheckError(errors.New("The local path '" + localPath + "' is not a directory."))
+	}
+	checksums := make(map[string]fileutils.ChecksumDetails)
+	err = filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != root && !recursive {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		details, err := fileutils.GetFileDetails(path)
+		if err != nil {
+			return err
+		}
+		relativePath, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		checksums[filepath.ToSlash(relativePath)] = details.Checksum
+		return nil
+	})
+	return checksums, errorutils.CheckError(err)
+}
+
+// Compares the local files with the expected files, both mapped by path, and returns the problems sorted by path.
+// Local files which are not expected are reported only if reportExtra is true.
+func compareWithExpected(localChecksums map[string]fileutils.ChecksumDetails, expected map[string]expectedChecksums, reportExtra bool) []VerifyResult {
+	result := []VerifyResult{}
+	for filePath, expectedFile := range expected {
+		local, exists := localChecksums[filePath]
+		if !exists {
+			result = append(result, VerifyResult{Path: filePath, Status: VerifyMissing, ExpectedSha1: expectedFile.Sha1, ExpectedSha256: expectedFile.Sha256})
+			continue
+		}
+		if local.Sha1 != expectedFile.Sha1 || (expectedFile.Sha256 != "" && local.Sha256 != expectedFile.Sha256) {
+			verifyResult := VerifyResult{Path: filePath, Status: VerifyCorrupted, ExpectedSha1: expectedFile.Sha1, ActualSha1: local.Sha1}
+			if expectedFile.Sha256 != "" {
+				verifyResult.ExpectedSha256, verifyResult.ActualSha256 = expectedFile.Sha256, local.Sha256
+			}
+			result = append(result, verifyResult)
+		}
+	}
+	if reportExtra {
+		for filePath, local := range localChecksums {
+			if _, exists := expected[filePath]; !exists {
+				result = append(result, VerifyResult{Path: filePath, Status: VerifyExtra, ActualSha1: local.Sha1, ActualSha256: local.Sha256})
+			}
+		}
+	}
+	sortVerifyResult(result)
+	return result
+}
+
+// Compares the local files with the files of a build.
+// Build-info records file names without paths, therefore a build file is verified by any local file with the same checksum.
+// If no such file exists, a local file with the same name is reported as corrupted. Otherwise, the build file is missing.
+func compareWithBuild(localChecksums map[string]fileutils.ChecksumDetails, files []buildFile) []VerifyResult {
+	bySha1 := make(map[string][]string)
+	byName := make(map[string][]string)
+	for filePath, local := range localChecksums {
+		bySha1[local.Sha1] = append(bySha1[local.Sha1], filePath)
+		byName[path.Base(filePath)] = append(byName[path.Base(filePath)], filePath)
+	}
+	matched := make(map[string]bool)
+	result := []VerifyResult{}
+	for _, file := range files {
+		if paths, exists := bySha1[file.Sha1]; exists {
+			for _, filePath := range paths {
+				matched[filePath] = true
+			}
+			continue
+		}
+		paths, exists := byName[path.Base(file.Name)]
+		if !exists {
+			result = append(result, VerifyResult{Path: file.Name, Status: VerifyMissing, ExpectedSha1: file.Sha1})
+			continue
+		}
+		for _, filePath := range paths {
+			matched[filePath] = true
+			result = append(result, VerifyResult{Path: filePath, Status: VerifyCorrupted, ExpectedSha1: file.Sha1, ActualSha1: localChecksums[filePath].Sha1})
+		}
+	}
+	for filePath, local := range localChecksums {
+		if !matched[filePath] {
+			result = append(result, VerifyResult{Path: filePath, Status: VerifyExtra, ActualSha1: local.Sha1})
+		}
+	}
+	sortVerifyResult(result)
+	return result
+}
+
+func sortVerifyResult(result []VerifyResult) {
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Path != result[j].Path {
+			return result[i].Path < result[j].Path
+		}
+		return result[i].ExpectedSha1 < result[j].ExpectedSha1
+	})
+}
+
+// Shows the verify results as a table of the expected and actual checksums of each file.
+func CreateVerifyTable(verifyResult []VerifyResult) string {
+	buffer := new(bytes.Buffer)
+	writer := tabwriter.NewWriter(buffer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(writer, "STATUS\tPATH\tEXPECTED SHA1\tACTUAL SHA1\tEXPECTED SHA256\tACTUAL SHA256")
+	for _, result := range verifyResult {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", result.Status, result.Path, result.ExpectedSha1, result.ActualSha1, result.ExpectedSha256, result.ActualSha256)
+	}
+	writer.Flush()
+	return strings.TrimSuffix(buffer.String(), "\n")
+}
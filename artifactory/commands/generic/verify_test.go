@@ -0,0 +1,181 @@
+package generic
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/spec"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+	serviceutils "github.com/jfrog/jfrog-client-go/artifactory/services/utils"
+	"github.com/jfrog/jfrog-client-go/utils/io/fileutils"
+)
+
+func TestCompareWithExpected(t *testing.T) {
+	local := map[string]fileutils.ChecksumDetails{
+		"a.jar":     {Sha1: "1", Sha256: "11"},
+		"b/c.jar":   {Sha1: "2", Sha256: "22"},
+		"d.jar":     {Sha1: "3", Sha256: "bad"},
+		"extra.txt": {Sha1: "4", Sha256: "44"},
+	}
+	expected := map[string]expectedChecksums{
+		"a.jar":       {Sha1: "1", Sha256: "11"},
+		"b/c.jar":     {Sha1: "bad"},
+		"d.jar":       {Sha1: "3", Sha256: "33"},
+		"missing.jar": {Sha1: "5"},
+	}
+	expectedResult := []VerifyResult{
+		{Path: "b/c.jar", Status: VerifyCorrupted, ExpectedSha1: "bad", ActualSha1: "2"},
+		{Path: "d.jar", Status: VerifyCorrupted, ExpectedSha1: "3", ActualSha1: "3", ExpectedSha256: "33", ActualSha256: "bad"},
+		{Path: "extra.txt", Status: VerifyExtra, ActualSha1: "4", ActualSha256: "44"},
+		{Path: "missing.jar", Status: VerifyMissing, ExpectedSha1: "5"},
+	}
+	if actual := compareWithExpected(local, expected, true); !reflect.DeepEqual(expectedResult, actual) {
+		t.Errorf("Expected %v, got %v.", expectedResult, actual)
+	}
+	expectedResult = append(expectedResult[:2], expectedResult[3])
+	if actual := compareWithExpected(local, expected, false); !reflect.DeepEqual(expectedResult, actual) {
+		t.Errorf("Expected %v, got %v.", expectedResult, actual)
+	}
+}
+
+func TestCompareWithBuild(t *testing.T) {
+	local := map[string]fileutils.ChecksumDetails{
+		"lib/renamed.jar": {Sha1: "1"},
+		"app.war":         {Sha1: "bad"},
+		"notes.txt":       {Sha1: "3"},
+	}
+	files := []buildFile{
+		{Name: "lib.jar", Sha1: "1"},
+		{Name: "app.war", Sha1: "2"},
+		{Name: "org.acme:dep:1.0", Sha1: "4"},
+	}
+	expected := []VerifyResult{
+		{Path: "app.war", Status: VerifyCorrupted, ExpectedSha1: "2", ActualSha1: "bad"},
+		{Path: "notes.txt", Status: VerifyExtra, ActualSha1: "3"},
+		{Path: "org.acme:dep:1.0", Status: VerifyMissing, ExpectedSha1: "4"},
+	}
+	if actual := compareWithBuild(local, files); !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestParseVerifyAqlResult(t *testing.T) {
+	content := []byte(`{"results":[{"repo":"repo","path":"a/b","name":"c.jar","actual_sha1":"1","sha256":"11"},{"repo":"repo","path":"a","name":"d.jar","actual_sha1":"2"}]}`)
+	expected := map[string]expectedChecksums{
+		"b/c.jar": {Sha1: "1", Sha256: "11"},
+		"d.jar":   {Sha1: "2"},
+	}
+	actual, err := parseVerifyAqlResult(content, "repo/a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected %v, got %v.", expected, actual)
+	}
+}
+
+func TestCreateRepoPathAqlCriteria(t *testing.T) {
+	tests := []struct {
+		repoPath  string
+		recursive bool
+		expected  []string
+	}{
+		{"repo", true, []string{`{"repo":"repo"}`, `{"type":"file"}`}},
+		{"repo", false, []string{`{"repo":"repo"}`, `{"type":"file"}`, `{"path":"."}`}},
+		{"repo/a", true, []string{`{"repo":"repo"}`, `{"type":"file"}`, `{"$or":[{"path":"a"},{"path":{"$match":"a/*"}}]}`}},
+		{"repo/a", false, []string{`{"repo":"repo"}`, `{"type":"file"}`, `{"path":"a"}`}},
+	}
+	for _, test := range tests {
+		if actual := createRepoPathAqlCriteria(test.repoPath, "file", test.recursive); !reflect.DeepEqual(test.expected, actual) {
+			t.Errorf("Repo path '%s': expected %v, got %v.", test.repoPath, test.expected, actual)
+		}
+	}
+}
+
+func TestGetDownloadLocalPath(t *testing.T) {
+	item := serviceutils.ResultItem{Repo: "repo", Path: "a/b", Name: "c.jar"}
+	tests := []struct {
+		pattern  string
+		target   string
+		flat     bool
+		expected string
+	}{
+		{"repo/a/*", "", false, filepath.Join("a", "b", "c.jar")},
+		{"repo/a/*", "out/", false, filepath.Join("out", "a", "b", "c.jar")},
+		{"repo/a/*", "out/", true, filepath.Join("out", "c.jar")},
+		{"repo/a/(*)/c.jar", "out/{1}.jar", true, filepath.Join("out", "b.jar")},
+	}
+	for _, test := range tests {
+		file := spec.NewBuilder().Pattern(test.pattern).Target(test.target).BuildSpec().Get(0)
+		actual, err := getDownloadLocalPath(file, test.flat, item)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != test.expected {
+			t.Errorf("Pattern '%s', target '%s': expected %s, got %s.", test.pattern, test.target, test.expected, actual)
+		}
+	}
+}
+
+func TestVerifySpecSha256(t *testing.T) {
+	log.SetDefaultLogger()
+	tempDir, err := ioutil.TempDir("", "verify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	localPath := filepath.Join(tempDir, "a.jar")
+	if err = ioutil.WriteFile(localPath, []byte("content"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	details, err := fileutils.GetFileDetails(localPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sha1 := details.Checksum.Sha1
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if r.URL.Path != "/api/search/aql" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// The SHA-1 checksum in Artifactory matches the local file, but the SHA-256 checksum does not.
+		if strings.Contains(string(body), `"sha256"`) {
+			fmt.Fprintf(w, `{"results":[{"repo":"repo","path":"dir","name":"a.jar","actual_sha1":"%s","sha256":"bad"}]}`, sha1)
+			return
+		}
+		fmt.Fprintf(w, `{"results":[{"repo":"repo","path":"dir","name":"a.jar","type":"file","actual_sha1":"%s"}]}`, sha1)
+	}))
+	defer ts.Close()
+	verifyCommand := NewVerifyCommand()
+	verifyCommand.SetRtDetails(&config.ArtifactoryDetails{Url: ts.URL + "/"}).
+		SetSpec(spec.NewBuilder().Pattern("repo/dir/*").Target(tempDir + "/").Flat(true).BuildSpec())
+	if err = verifyCommand.Run(); err != nil {
+		t.Fatal(err)
+	}
+	expected := []VerifyResult{{Path: localPath, Status: VerifyCorrupted, ExpectedSha1: sha1, ActualSha1: sha1, ExpectedSha256: "bad", ActualSha256: details.Checksum.Sha256}}
+	if !reflect.DeepEqual(expected, verifyCommand.VerifyResult()) {
+		t.Errorf("Expected %v, got %v.", expected, verifyCommand.VerifyResult())
+	}
+}
+
+func TestCreateVerifyTable(t *testing.T) {
+	verifyResult := []VerifyResult{
+		{Path: "a/b.txt", Status: VerifyCorrupted, ExpectedSha1: "1", ActualSha1: "2", ExpectedSha256: "3", ActualSha256: "4"},
+		{Path: "c.txt", Status: VerifyMissing, ExpectedSha1: "5", ActualSha1: "-", ExpectedSha256: "6", ActualSha256: "-"},
+	}
+	expected := "STATUS     PATH     EXPECTED SHA1  ACTUAL SHA1  EXPECTED SHA256  ACTUAL SHA256\n" +
+		"corrupted  a/b.txt  1              2            3                4\n" +
+		"missing    c.txt    5              -            6                -"
+	if actual := CreateVerifyTable(verifyResult); actual != expected {
+		t.Errorf("Expected:\n%s\nGot:\n%s", expected, actual)
+	}
+}
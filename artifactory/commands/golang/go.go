@@ -7,6 +7,7 @@ import (
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils/golang"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils/golang/project"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/artifactory/services/go"
 	"github.com/jfrog/jfrog-client-go/utils/version"
@@ -93,6 +94,15 @@ func (gc *GoCommand) Run() error {
 	if err != nil {
 		return err
 	}
+	// Resolve the modules through the download cache, if enabled.
+	if downloadcache.IsEnabled(false) {
+		var proxy *downloadcache.Proxy
+		proxy, resolverDetails, err = utils.StartDownloadCacheProxy(resolverDetails)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+	}
 	resolverServiceManager, err := utils.CreateServiceManager(resolverDetails, false)
 	if err != nil {
 		return err
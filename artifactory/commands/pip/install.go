@@ -3,6 +3,7 @@ package pip
 import (
 	"fmt"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils/dependenciestree"
 	piputils "github.com/jfrog/jfrog-cli-go/artifactory/utils/pip"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils/pip/dependencies"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
@@ -74,7 +75,12 @@ func (pic *PipInstallCommand) collectBuildInfo(pythonExecutablePath string, depe
 
 	promptMissingDependencies(missingDeps)
 	dependencies.UpdateDependenciesCache(allDependencies)
-	pic.saveBuildInfo(allDependencies)
+	// The dependency graph is used when exporting an SBOM of the build, so failing to read it does not fail the command.
+	childrenMap, err := dependencies.BuildPipChildrenMap(pythonExecutablePath)
+	if err != nil {
+		log.Warn("Failed reading the dependency graph of the installed packages:", err.Error())
+	}
+	pic.saveBuildInfo(allDependencies, childrenMap)
 	return nil
 }
 
@@ -88,7 +94,7 @@ func (pic *PipInstallCommand) getAllDependencies(dependencyToFileMap map[string]
 	return dependenciesMap
 }
 
-func (pic *PipInstallCommand) saveBuildInfo(allDependencies map[string]*buildinfo.Dependency) {
+func (pic *PipInstallCommand) saveBuildInfo(allDependencies map[string]*buildinfo.Dependency, childrenMap map[string][]string) {
 	buildInfo := &buildinfo.BuildInfo{}
 	var modules []buildinfo.Module
 	var projectDependencies []buildinfo.Dependency
@@ -99,6 +105,7 @@ func (pic *PipInstallCommand) saveBuildInfo(allDependencies map[string]*buildinf
 
 	// Save build-info.
 	module := buildinfo.Module{Id: pic.buildConfiguration.Module, Dependencies: projectDependencies}
+	module.Properties = dependenciestree.CreateDependenciesProperties("pypi", allDependencies, childrenMap)
 	modules = append(modules, module)
 
 	buildInfo.Modules = modules
@@ -3,22 +3,23 @@ package spec
 import "strconv"
 
 type builder struct {
-	pattern         string
-	excludePatterns []string
-	target          string
-	explode         string
-	props           string
-	excludeProps	string
-	sortOrder       string
-	sortBy          []string
-	offset          int
-	limit           int
-	build           string
-	recursive       bool
-	flat            bool
-	regexp          bool
-	includeDirs     bool
-	archiveEntries  string
+	pattern            string
+	excludePatterns    []string
+	target             string
+	explode            string
+	props              string
+	excludeProps       string
+	sortOrder          string
+	sortBy             []string
+	offset             int
+	limit              int
+	build              string
+	recursive          bool
+	flat               bool
+	regexp             bool
+	includeDirs        bool
+	archiveEntries     string
+	respectIgnoreFiles bool
 }
 
 func NewBuilder() *builder {
@@ -105,26 +106,32 @@ func (b *builder) IncludeDirs(includeDirs bool) *builder {
 	return b
 }
 
+func (b *builder) RespectIgnoreFiles(respectIgnoreFiles bool) *builder {
+	b.respectIgnoreFiles = respectIgnoreFiles
+	return b
+}
+
 func (b *builder) BuildSpec() *SpecFiles {
 	return &SpecFiles{
 		Files: []File{
 			{
-				Pattern:         b.pattern,
-				ExcludePatterns: b.excludePatterns,
-				Target:          b.target,
-				Props:           b.props,
-				ExcludeProps:	 b.excludeProps,
-				SortOrder:       b.sortOrder,
-				SortBy:          b.sortBy,
-				Offset:          b.offset,
-				Limit:           b.limit,
-				Build:           b.build,
-				Explode:         b.explode,
-				Recursive:       strconv.FormatBool(b.recursive),
-				Flat:            strconv.FormatBool(b.flat),
-				Regexp:          strconv.FormatBool(b.regexp),
-				IncludeDirs:     strconv.FormatBool(b.includeDirs),
-				ArchiveEntries:  b.archiveEntries,
+				Pattern:            b.pattern,
+				ExcludePatterns:    b.excludePatterns,
+				Target:             b.target,
+				Props:              b.props,
+				ExcludeProps:       b.excludeProps,
+				SortOrder:          b.sortOrder,
+				SortBy:             b.sortBy,
+				Offset:             b.offset,
+				Limit:              b.limit,
+				Build:              b.build,
+				Explode:            b.explode,
+				Recursive:          strconv.FormatBool(b.recursive),
+				Flat:               strconv.FormatBool(b.flat),
+				Regexp:             strconv.FormatBool(b.regexp),
+				IncludeDirs:        strconv.FormatBool(b.includeDirs),
+				ArchiveEntries:     b.archiveEntries,
+				RespectIgnoreFiles: strconv.FormatBool(b.respectIgnoreFiles),
 			},
 		},
 	}
@@ -55,23 +55,24 @@ func replaceSpecVars(content []byte, specVars map[string]string) []byte {
 }
 
 type File struct {
-	Aql             utils.Aql
-	Pattern         string
-	ExcludePatterns []string
-	Target          string
-	Explode         string
-	Props           string
-	ExcludeProps    string
-	SortOrder       string
-	SortBy          []string
-	Offset          int
-	Limit           int
-	Build           string
-	Recursive       string
-	Flat            string
-	Regexp          string
-	IncludeDirs     string
-	ArchiveEntries  string
+	Aql                utils.Aql
+	Pattern            string
+	ExcludePatterns    []string
+	Target             string
+	Explode            string
+	Props              string
+	ExcludeProps       string
+	SortOrder          string
+	SortBy             []string
+	Offset             int
+	Limit              int
+	Build              string
+	Recursive          string
+	Flat               string
+	Regexp             string
+	IncludeDirs        string
+	ArchiveEntries     string
+	RespectIgnoreFiles string
 }
 
 func (f File) IsFlat(defaultValue bool) (bool, error) {
@@ -94,6 +95,10 @@ func (f File) IsIncludeDirs(defaultValue bool) (bool, error) {
 	return clientutils.StringToBool(f.IncludeDirs, defaultValue)
 }
 
+func (f File) IsRespectIgnoreFiles(defaultValue bool) (bool, error) {
+	return clientutils.StringToBool(f.RespectIgnoreFiles, defaultValue)
+}
+
 func (f *File) ToArtifactoryCommonParams() *utils.ArtifactoryCommonParams {
 	params := new(utils.ArtifactoryCommonParams)
 	params.Aql = f.Aql
@@ -14,6 +14,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -23,8 +24,7 @@ const BuildInfoDetails = "details"
 const BuildTempPath = "jfrog/builds/"
 
 func GetBuildDir(buildName, buildNumber string) (string, error) {
-	encodedDirName := base64.StdEncoding.EncodeToString([]byte(buildName + "_" + buildNumber))
-	buildsDir := filepath.Join(cliutils.GetCliPersistentTempDirPath(), BuildTempPath, encodedDirName)
+	buildsDir := getBuildDirPath(buildName, buildNumber)
 	err := os.MkdirAll(buildsDir, 0777)
 	if errorutils.CheckError(err) != nil {
 		return "", err
@@ -32,6 +32,113 @@ func GetBuildDir(buildName, buildNumber string) (string, error) {
 	return buildsDir, nil
 }
 
+// Returns the directory which includes the build-info data of all the builds which were not published yet.
+func GetBuildsDir() string {
+	return filepath.Join(cliutils.GetCliPersistentTempDirPath(), BuildTempPath)
+}
+
+func getBuildDirPath(buildName, buildNumber string) string {
+	encodedDirName := base64.StdEncoding.EncodeToString([]byte(buildName + "_" + buildNumber))
+	return filepath.Join(GetBuildsDir(), encodedDirName)
+}
+
+// Returns true if build-info data was collected locally for the build, without creating its directory.
+func IsBuildDirExists(buildName, buildNumber string) (bool, error) {
+	return fileutils.IsDirExists(getBuildDirPath(buildName, buildNumber), false)
+}
+
+// The build-info data collected locally for a build which was not published yet.
+type LocalBuild struct {
+	Name   string `json:"name,omitempty"`
+	Number string `json:"number,omitempty"`
+	// The number of partial build-info files, including the build-info files generated by the build tools.
+	Partials int `json:"partials"`
+	// The time in which the build-info data started being collected.
+	Started time.Time `json:"started"`
+	Dir     string    `json:"dir,omitempty"`
+}
+
+// Returns the builds with build-info data collected locally, sorted by their start time.
+func GetLocalBuilds() ([]LocalBuild, error) {
+	return getLocalBuilds(GetBuildsDir())
+}
+
+func getLocalBuilds(buildsDir string) ([]LocalBuild, error) {
+	exists, err := fileutils.IsDirExists(buildsDir, false)
+	if err != nil || !exists {
+		return nil, err
+	}
+	dirs, err := ioutil.ReadDir(buildsDir)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	var localBuilds []LocalBuild
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		localBuild, err := readLocalBuild(filepath.Join(buildsDir, dir.Name()), dir)
+		if err != nil {
+			return nil, err
+		}
+		if localBuild != nil {
+			localBuilds = append(localBuilds, *localBuild)
+		}
+	}
+	sort.Slice(localBuilds, func(i, j int) bool {
+		return localBuilds[i].Started.Before(localBuilds[j].Started)
+	})
+	return localBuilds, nil
+}
+
+func readLocalBuild(buildDir string, dirInfo os.FileInfo) (*LocalBuild, error) {
+	decodedDirName, err := base64.StdEncoding.DecodeString(dirInfo.Name())
+	if err != nil {
+		log.Debug("Skipping", buildDir+", which is not a build directory.")
+		return nil, nil
+	}
+	// The directory name is <build name>_<build number>. Build names are more likely than build numbers to include underscores.
+	dirName := string(decodedDirName)
+	separator := strings.LastIndex(dirName, "_")
+	if separator < 0 {
+		log.Debug("Skipping", buildDir+", which is not a build directory.")
+		return nil, nil
+	}
+	localBuild := &LocalBuild{Name: dirName[:separator], Number: dirName[separator+1:], Started: dirInfo.ModTime(), Dir: buildDir}
+	buildFiles, err := ioutil.ReadDir(buildDir)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, buildFile := range buildFiles {
+		if !buildFile.IsDir() {
+			localBuild.Partials++
+		}
+	}
+	partialsDir := filepath.Join(buildDir, "partials")
+	partialFiles, err := ioutil.ReadDir(partialsDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errorutils.CheckError(err)
+	}
+	for _, partialFile := range partialFiles {
+		if partialFile.IsDir() {
+			continue
+		}
+		if partialFile.Name() != BuildInfoDetails {
+			localBuild.Partials++
+			continue
+		}
+		content, err := ioutil.ReadFile(filepath.Join(partialsDir, BuildInfoDetails))
+		if err != nil {
+			return nil, errorutils.CheckError(err)
+		}
+		details := new(buildinfo.General)
+		if json.Unmarshal(content, details) == nil && !details.Timestamp.IsZero() {
+			localBuild.Started = details.Timestamp
+		}
+	}
+	return localBuild, nil
+}
+
 func CreateBuildProperties(buildName, buildNumber string) (string, error) {
 	if buildName == "" || buildNumber == "" {
 		return "", nil
@@ -120,6 +227,8 @@ func SaveBuildGeneralDetails(buildName, buildNumber string) error {
 	if exists {
 		return nil
 	}
+	// A new build starts, so this is a good time to remove the data left behind by builds which were never published or cleaned.
+	RemoveExpiredTempData()
 	meta := buildinfo.General{
 		Timestamp: time.Now(),
 	}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestGetLocalBuilds(t *testing.T) {
+	buildName, buildNumber := "local_builds_test", strconv.FormatInt(time.Now().UnixNano(), 10)
+	defer RemoveBuildDir(buildName, buildNumber)
+	if err := SaveBuildGeneralDetails(buildName, buildNumber); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		err := SavePartialBuildInfo(buildName, buildNumber, func(partial *buildinfo.Partial) {
+			partial.Env = buildinfo.Env{"key": "value"}
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	localBuilds, err := GetLocalBuilds()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var localBuild *LocalBuild
+	for i := range localBuilds {
+		if localBuilds[i].Name == buildName && localBuilds[i].Number == buildNumber {
+			localBuild = &localBuilds[i]
+		}
+	}
+	if localBuild == nil {
+		t.Fatalf("Expected build %s/%s to be listed.", buildName, buildNumber)
+	}
+	if localBuild.Partials != 2 {
+		t.Errorf("Expected 2 partials, got %d.", localBuild.Partials)
+	}
+	if time.Since(localBuild.Started) > time.Minute {
+		t.Errorf("Unexpected start time %v.", localBuild.Started)
+	}
+}
@@ -2,6 +2,9 @@ package dependenciestree
 
 import (
 	"encoding/json"
+	"sort"
+	"strings"
+
 	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 )
 
@@ -61,3 +64,74 @@ func CreateDependencyTree(rootDependencies []string, allDependencies map[string]
 	}
 	return rootTree
 }
+
+// The build-info dependencies have no field for the dependencies of each dependency, so the dependency graph of a module is kept
+// in its properties. Each dependency which has dependencies of its own has a property named after its ID with this prefix,
+// which holds the comma separated IDs of its dependencies.
+const ChildrenPropertyPrefix = "dependencies.children."
+
+// The package type of the dependencies of a module, in the form of a package URL type, such as nuget or pypi.
+// Unlike Maven, npm and Go dependencies, the type of dependencies identified by <name>:<version> can't be detected from their IDs.
+const PackageTypeProperty = "dependencies.type"
+
+// Creates the module properties which hold the package type of the dependencies and their dependency graph.
+func CreateDependenciesProperties(packageType string, allDependencies map[string]*buildinfo.Dependency, childrenMap map[string][]string) map[string]string {
+	properties := CreateChildrenProperties(allDependencies, childrenMap)
+	properties[PackageTypeProperty] = packageType
+	return properties
+}
+
+// Creates the module properties which hold the dependency graph. The dependencies and the children map are keyed by the same names,
+// which are replaced by the IDs of the dependencies. Children which are not dependencies of the module are skipped.
+func CreateChildrenProperties(allDependencies map[string]*buildinfo.Dependency, childrenMap map[string][]string) map[string]string {
+	properties := make(map[string]string)
+	for parent, children := range childrenMap {
+		parentDependency, ok := allDependencies[parent]
+		if !ok || parentDependency.Id == "" {
+			continue
+		}
+		var childrenIds []string
+		for _, child := range children {
+			if childDependency, ok := allDependencies[child]; ok && childDependency.Id != "" {
+				childrenIds = append(childrenIds, childDependency.Id)
+			}
+		}
+		if len(childrenIds) > 0 {
+			sort.Strings(childrenIds)
+			properties[ChildrenPropertyPrefix+parentDependency.Id] = strings.Join(childrenIds, ",")
+		}
+	}
+	return properties
+}
+
+// Returns the IDs of the dependencies of each dependency, from the properties of a module.
+func ReadChildrenProperties(moduleProperties interface{}) map[string][]string {
+	childrenMap := make(map[string][]string)
+	for key, value := range readModuleProperties(moduleProperties) {
+		if strings.HasPrefix(key, ChildrenPropertyPrefix) && value != "" {
+			childrenMap[strings.TrimPrefix(key, ChildrenPropertyPrefix)] = strings.Split(value, ",")
+		}
+	}
+	return childrenMap
+}
+
+// Returns the package type of the dependencies, from the properties of a module, or an empty string if it is not known.
+func ReadPackageTypeProperty(moduleProperties interface{}) string {
+	return readModuleProperties(moduleProperties)[PackageTypeProperty]
+}
+
+// The properties of a module which was read from a JSON build-info are a map of interface values.
+func readModuleProperties(moduleProperties interface{}) map[string]string {
+	properties := make(map[string]string)
+	switch typedProperties := moduleProperties.(type) {
+	case map[string]string:
+		properties = typedProperties
+	case map[string]interface{}:
+		for key, value := range typedProperties {
+			if stringValue, ok := value.(string); ok {
+				properties[key] = stringValue
+			}
+		}
+	}
+	return properties
+}
@@ -0,0 +1,41 @@
+package dependenciestree
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+func TestChildrenProperties(t *testing.T) {
+	allDependencies := map[string]*buildinfo.Dependency{
+		"parent": {Id: "Parent:1.0"},
+		"child":  {Id: "Child:2.0"},
+		"other":  {Id: "Other:3.0"},
+	}
+	childrenMap := map[string][]string{"parent": {"other", "child", "missing"}, "child": {}}
+	properties := CreateChildrenProperties(allDependencies, childrenMap)
+	expectedProperties := map[string]string{ChildrenPropertyPrefix + "Parent:1.0": "Child:2.0,Other:3.0"}
+	if !reflect.DeepEqual(properties, expectedProperties) {
+		t.Errorf("Expected %v, got %v.", expectedProperties, properties)
+	}
+
+	// The properties are read back from a build-info module, which was saved as JSON.
+	properties = CreateDependenciesProperties("nuget", allDependencies, childrenMap)
+	content, err := json.Marshal(buildinfo.Module{Id: "module", Properties: properties})
+	if err != nil {
+		t.Fatal(err)
+	}
+	module := &buildinfo.Module{}
+	if err = json.Unmarshal(content, module); err != nil {
+		t.Fatal(err)
+	}
+	expectedChildren := map[string][]string{"Parent:1.0": {"Child:2.0", "Other:3.0"}}
+	if actual := ReadChildrenProperties(module.Properties); !reflect.DeepEqual(actual, expectedChildren) {
+		t.Errorf("Expected %v, got %v.", expectedChildren, actual)
+	}
+	if actual := ReadPackageTypeProperty(module.Properties); actual != "nuget" {
+		t.Error("Expected the package type nuget, got", actual)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/jfrog/jfrog-cli-go/bintray/commands"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
 	rthttpclient "github.com/jfrog/jfrog-client-go/artifactory/httpclient"
 	"github.com/jfrog/jfrog-client-go/bintray"
 	"github.com/jfrog/jfrog-client-go/bintray/auth"
@@ -35,6 +36,8 @@ const (
 // If the JCenterRemoteServerEnv environment variable is configured, the jar will be
 // downloaded from a remote Artifactory repository which proxies jcenter.
 //
+// If the download cache is enabled, the jar is taken from the cache when possible, and added to it after it is downloaded.
+//
 // downloadPath: The Bintray or Artifactory download path.
 // filename: The local file name.
 // targetPath: The local download path (without the file name).
@@ -45,6 +48,34 @@ func DownloadExtractorIfNeeded(downloadPath, targetPath string) error {
 		return err
 	}
 
+	var downloadCache *downloadcache.DownloadCache
+	if downloadcache.IsEnabled(false) {
+		downloadCache, err = downloadcache.GetDownloadCache()
+		if err != nil {
+			return err
+		}
+		// The released extractors never change, so they are looked up by their path in jcenter, regardless of the server they are downloaded from.
+		// The cache is used on a best-effort basis, so errors are logged and do not fail the download.
+		found, err := downloadCache.GetByKey(downloadPath, targetPath)
+		if err != nil {
+			log.Warn("Failed reading", downloadPath, "from the download cache:", err.Error())
+		} else if found {
+			log.Debug("Restored", downloadPath, "from the download cache.")
+			return nil
+		}
+	}
+
+	err = downloadExtractor(downloadPath, targetPath)
+	if err != nil || downloadCache == nil {
+		return err
+	}
+	if err = downloadCache.PutByKey(downloadPath, targetPath); err != nil {
+		log.Warn("Failed adding", targetPath, "to the download cache:", err.Error())
+	}
+	return nil
+}
+
+func downloadExtractor(downloadPath, targetPath string) error {
 	artDetails, remotePath, err := GetJcenterRemoteDetails(downloadPath)
 	if err != nil {
 		return err
@@ -140,6 +171,6 @@ func downloadFileFromBintray(downloadPath, targetPath string) error {
 	params.TargetPath = targetPath
 	params.Flat = true
 
-	_, _, err = commands.DownloadFile(config, params)
+	_, _, err = commands.DownloadFile(config, params, 0)
 	return err
 }
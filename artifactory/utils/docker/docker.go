@@ -121,6 +121,68 @@ func (pushCmd *pushCmd) GetErrWriter() io.WriteCloser {
 	return nil
 }
 
+// Adds a tag to the image
+func TagImage(imageTag, newTag string) error {
+	return gofrogcmd.RunCmd(&tagCmd{image: &image{tag: imageTag}, newTag: newTag})
+}
+
+// Removes a tag of an image, without removing the image itself if it has other tags
+func RemoveImageTag(imageTag string) error {
+	return gofrogcmd.RunCmd(&removeTagCmd{image: &image{tag: imageTag}})
+}
+
+// Image tag command
+type tagCmd struct {
+	image  *image
+	newTag string
+}
+
+func (tagCmd *tagCmd) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, "docker")
+	cmd = append(cmd, "tag")
+	cmd = append(cmd, tagCmd.image.tag)
+	cmd = append(cmd, tagCmd.newTag)
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (tagCmd *tagCmd) GetEnv() map[string]string {
+	return map[string]string{}
+}
+
+func (tagCmd *tagCmd) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (tagCmd *tagCmd) GetErrWriter() io.WriteCloser {
+	return nil
+}
+
+// Image tag removal command
+type removeTagCmd struct {
+	image *image
+}
+
+func (removeTagCmd *removeTagCmd) GetCmd() *exec.Cmd {
+	var cmd []string
+	cmd = append(cmd, "docker")
+	cmd = append(cmd, "rmi")
+	cmd = append(cmd, removeTagCmd.image.tag)
+	return exec.Command(cmd[0], cmd[1:]...)
+}
+
+func (removeTagCmd *removeTagCmd) GetEnv() map[string]string {
+	return map[string]string{}
+}
+
+func (removeTagCmd *removeTagCmd) GetStdWriter() io.WriteCloser {
+	return nil
+}
+
+func (removeTagCmd *removeTagCmd) GetErrWriter() io.WriteCloser {
+	return nil
+}
+
 // Image get image id command
 type getImageIdCmd struct {
 	image *image
@@ -268,6 +330,17 @@ func CreateServiceManager(artDetails *config.ArtifactoryDetails, threads int) (*
 	return artifactory.New(&artAuth, serviceConfig)
 }
 
+// Returns the credentials used for accessing the docker registries of the Artifactory server.
+// If access-token exists, it is used as the password.
+func getRegistryCredentials(artDetails *config.ArtifactoryDetails) (username, password string, err error) {
+	if artDetails.AccessToken != "" {
+		log.Debug("Using access-token details for the docker registry.")
+		username, err = auth.ExtractUsernameFromAccessToken(artDetails.AccessToken)
+		return username, artDetails.AccessToken, err
+	}
+	return artDetails.User, artDetails.Password, nil
+}
+
 // First will try to login assuming a proxy-less tag (e.g. "registry-address/docker-repo/image:ver").
 // If fails, we will try assuming a reverse proxy tag (e.g. "registry-address-docker-repo/image:ver").
 func DockerLogin(imageTag string, config *DockerLoginConfig) error {
@@ -276,16 +349,9 @@ func DockerLogin(imageTag string, config *DockerLoginConfig) error {
 		return err
 	}
 
-	username := config.ArtifactoryDetails.User
-	password := config.ArtifactoryDetails.Password
-	// If access-token exists, perform login with it.
-	if config.ArtifactoryDetails.AccessToken != "" {
-		log.Debug("Using access-token details in docker-login command.")
-		username, err = auth.ExtractUsernameFromAccessToken(config.ArtifactoryDetails.AccessToken)
-		if err != nil {
-			return err
-		}
-		password = config.ArtifactoryDetails.AccessToken
+	username, password, err := getRegistryCredentials(config.ArtifactoryDetails)
+	if err != nil {
+		return err
 	}
 
 	// Perform login.
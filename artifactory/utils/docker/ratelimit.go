@@ -0,0 +1,106 @@
+package docker
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
+	"github.com/jfrog/jfrog-client-go/httpclient"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// A local docker registry, which forwards the requests of the docker daemon to the registry of an image, while limiting their transfer rate.
+// The image layers are uploaded by the docker daemon rather than by JFrog CLI, so the image is pushed through this registry to throttle them.
+// The docker daemon connects to registries on the loopback interface using plain HTTP, so the registry requires no certificate.
+// Since the daemon connects to the registry on its own loopback interface, it should run on the same machine as JFrog CLI.
+type RateLimitedRegistry struct {
+	registry     string
+	upstream     *url.URL
+	username     string
+	password     string
+	reverseProxy *httputil.ReverseProxy
+	listener     net.Listener
+}
+
+// Starts a rate limited registry, which forwards the requests to the registry of the image tag.
+// Requests without credentials are sent with the credentials of the Artifactory server.
+func StartRateLimitedRegistry(imageTag string, rtDetails *config.ArtifactoryDetails, limiter *ratelimit.Limiter) (*RateLimitedRegistry, error) {
+	indexOfFirstSlash := strings.Index(imageTag, "/")
+	if indexOfFirstSlash < 0 {
+		return nil, errorutils.CheckError(errors.New("Invalid image tag received for pushing to Artifactory - tag does not include a slash."))
+	}
+	registry := imageTag[:indexOfFirstSlash]
+	// Registries are accessed using HTTPS, unless the registry is the Artifactory server itself, accessed using HTTP.
+	upstream := &url.URL{Scheme: "https", Host: registry}
+	if rtUrl, err := url.Parse(rtDetails.GetUrl()); err == nil && rtUrl.Host == registry {
+		upstream.Scheme = rtUrl.Scheme
+	}
+	username, password, err := getRegistryCredentials(rtDetails)
+	if err != nil {
+		return nil, err
+	}
+	certPath, err := utils.GetJfrogSecurityDir()
+	if err != nil {
+		return nil, err
+	}
+	client, err := httpclient.ClientBuilder().
+		SetCertificatesPath(certPath).
+		SetInsecureTls(rtDetails.InsecureTls).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	rlr := &RateLimitedRegistry{registry: registry, upstream: upstream, username: username, password: password, listener: listener}
+	rlr.reverseProxy = httputil.NewSingleHostReverseProxy(upstream)
+	director := rlr.reverseProxy.Director
+	rlr.reverseProxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = upstream.Host
+		if req.Header.Get("Authorization") == "" && rlr.username != "" && rlr.password != "" {
+			req.SetBasicAuth(rlr.username, rlr.password)
+		}
+	}
+	rlr.reverseProxy.Transport = ratelimit.WrapTransport(client.Client.Transport, limiter)
+	rlr.reverseProxy.ModifyResponse = rlr.rewriteLocation
+	go http.Serve(listener, rlr.reverseProxy)
+	log.Debug("Started a rate limited registry for", registry, "on", rlr.Host())
+	return rlr, nil
+}
+
+// Returns the host of the rate limited registry, which replaces the registry in image tags.
+func (rlr *RateLimitedRegistry) Host() string {
+	return rlr.listener.Addr().String()
+}
+
+// Returns the tag of the image in the rate limited registry.
+func (rlr *RateLimitedRegistry) ProxiedTag(imageTag string) string {
+	return rlr.Host() + strings.TrimPrefix(imageTag, rlr.registry)
+}
+
+func (rlr *RateLimitedRegistry) Close() error {
+	return errorutils.CheckError(rlr.listener.Close())
+}
+
+// The registry returns the location of each layer upload. Locations in the registry are replaced with the rate limited registry,
+// so that the layers are uploaded through it.
+func (rlr *RateLimitedRegistry) rewriteLocation(resp *http.Response) error {
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil || location.Host != rlr.upstream.Host {
+		return nil
+	}
+	location.Scheme = "http"
+	location.Host = rlr.Host()
+	resp.Header.Set("Location", location.String())
+	return nil
+}
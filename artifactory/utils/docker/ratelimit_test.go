@@ -0,0 +1,70 @@
+package docker
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
+)
+
+func TestRateLimitedRegistry(t *testing.T) {
+	log.SetDefaultLogger()
+	var uploaded string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "user" || password != "password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v2/repo/image/blobs/uploads/":
+			w.Header().Set("Location", "http://"+r.Host+"/v2/repo/image/blobs/uploads/1234")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodPut && r.URL.Path == "/v2/repo/image/blobs/uploads/1234":
+			body, _ := ioutil.ReadAll(r.Body)
+			uploaded = string(body)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	registryHost := strings.TrimPrefix(ts.URL, "http://")
+	imageTag := registryHost + "/repo/image:1.0"
+	rtDetails := &config.ArtifactoryDetails{Url: ts.URL + "/artifactory/", User: "user", Password: "password"}
+	registry, err := StartRateLimitedRegistry(imageTag, rtDetails, ratelimit.NewLimiter(1024*1024))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer registry.Close()
+
+	if expected := registry.Host() + "/repo/image:1.0"; registry.ProxiedTag(imageTag) != expected {
+		t.Errorf("Expected the proxied tag %s, got %s.", expected, registry.ProxiedTag(imageTag))
+	}
+	// The credentials of the server are added, and the upload location is replaced with the rate limited registry.
+	resp, err := http.Post("http://"+registry.Host()+"/v2/repo/image/blobs/uploads/", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	location := resp.Header.Get("Location")
+	if resp.StatusCode != http.StatusAccepted || location != "http://"+registry.Host()+"/v2/repo/image/blobs/uploads/1234" {
+		t.Fatalf("Expected an upload location in the rate limited registry, got %d %s.", resp.StatusCode, location)
+	}
+	req, err := http.NewRequest(http.MethodPut, location, strings.NewReader("layer"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated || uploaded != "layer" {
+		t.Errorf("Expected the layer to be uploaded, got %d '%s'.", resp.StatusCode, uploaded)
+	}
+}
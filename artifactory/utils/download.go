@@ -2,7 +2,10 @@ package utils
 
 import (
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
 	"github.com/jfrog/jfrog-client-go/artifactory"
+	"github.com/jfrog/jfrog-client-go/httpclient"
 	"github.com/jfrog/jfrog-client-go/utils/io"
 )
 
@@ -25,9 +28,39 @@ func CreateDownloadServiceManager(artDetails *config.ArtifactoryDetails, flags *
 	if err != nil {
 		return nil, err
 	}
+	if flags.LimitRate > 0 {
+		progressBar = ratelimit.WrapProgress(progressBar, ratelimit.NewLimiter(flags.LimitRate))
+	}
 	return artifactory.NewWithProgress(&artAuth, servicesConfig, progressBar)
 }
 
+// Starts a download cache proxy to the Artifactory server, for package managers which download their packages by themselves.
+// Returns the proxy, which should be closed when the package manager is done, and a copy of the server details with the URL of the proxy.
+func StartDownloadCacheProxy(artDetails *config.ArtifactoryDetails) (*downloadcache.Proxy, *config.ArtifactoryDetails, error) {
+	downloadCache, err := downloadcache.GetDownloadCache()
+	if err != nil {
+		return nil, nil, err
+	}
+	certPath, err := GetJfrogSecurityDir()
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := httpclient.ClientBuilder().
+		SetCertificatesPath(certPath).
+		SetInsecureTls(artDetails.InsecureTls).
+		Build()
+	if err != nil {
+		return nil, nil, err
+	}
+	proxy, err := downloadcache.StartProxy(downloadCache, artDetails.GetUrl(), client.Client)
+	if err != nil {
+		return nil, nil, err
+	}
+	proxyDetails := *artDetails
+	proxyDetails.Url = proxy.Url()
+	return proxy, &proxyDetails, nil
+}
+
 type DownloadConfiguration struct {
 	Threads         int
 	SplitCount      int
@@ -35,4 +68,8 @@ type DownloadConfiguration struct {
 	Symlink         bool
 	ValidateSymlink bool
 	Retries         int
+	// Maximum transfer rate in bytes per second, shared by all threads. 0 means unlimited.
+	LimitRate int64
+	// If true, files are taken from the local download cache when possible, and downloaded files are added to it.
+	UseCache bool
 }
@@ -0,0 +1,136 @@
+package git
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// A section of a git config file, such as [remote "origin"] or [submodule "lib"].
+type configSection struct {
+	// The section name is case insensitive, so it is kept in lower case.
+	name       string
+	subsection string
+	// The variable names are case insensitive, so they are kept in lower case.
+	values map[string][]string
+}
+
+// The sections of a git config file, in their order in the file.
+type config []*configSection
+
+// Parses a git config file, such as .git/config or .gitmodules.
+func readConfig(path string) (config, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer file.Close()
+	var result config
+	var section *configSection
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if line[0] == '[' {
+			section = parseSectionHeader(line)
+			result = append(result, section)
+			// A variable may follow the header in the same line.
+			line = strings.TrimSpace(line[strings.Index(line, "]")+1:])
+			if line == "" {
+				continue
+			}
+		}
+		if section == nil {
+			continue
+		}
+		name, value := parseVariable(line)
+		section.values[name] = append(section.values[name], value)
+	}
+	return result, errorutils.CheckError(scanner.Err())
+}
+
+// Parses [name "subsection"], or the deprecated [name.subsection].
+func parseSectionHeader(line string) *configSection {
+	header := line[1:]
+	if i := strings.Index(header, "]"); i >= 0 {
+		header = header[:i]
+	}
+	section := &configSection{values: make(map[string][]string)}
+	if i := strings.Index(header, "\""); i >= 0 {
+		section.name = strings.ToLower(strings.TrimSpace(header[:i]))
+		section.subsection = strings.Replace(strings.Trim(header[i:], "\""), "\\\"", "\"", -1)
+	} else if i := strings.Index(header, "."); i >= 0 {
+		section.name = strings.ToLower(header[:i])
+		section.subsection = header[i+1:]
+	} else {
+		section.name = strings.ToLower(strings.TrimSpace(header))
+	}
+	return section
+}
+
+// Parses "name = value". The value may be quoted, and may be followed by a comment.
+// A variable without a value is a true boolean.
+func parseVariable(line string) (string, string) {
+	i := strings.Index(line, "=")
+	if i < 0 {
+		return strings.ToLower(line), "true"
+	}
+	name := strings.ToLower(strings.TrimSpace(line[:i]))
+	var value strings.Builder
+	inQuotes := false
+	rawValue := strings.TrimSpace(line[i+1:])
+	for j := 0; j < len(rawValue); j++ {
+		c := rawValue[j]
+		switch {
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == '\\' && j+1 < len(rawValue):
+			j++
+			switch rawValue[j] {
+			case 'n':
+				value.WriteByte('\n')
+			case 't':
+				value.WriteByte('\t')
+			default:
+				value.WriteByte(rawValue[j])
+			}
+		case (c == '#' || c == ';') && !inQuotes:
+			return name, strings.TrimSpace(value.String())
+		default:
+			value.WriteByte(c)
+		}
+	}
+	return name, strings.TrimSpace(value.String())
+}
+
+// Returns the first value of the variable in the section, or an empty string if it is not set.
+func (c config) get(name, subsection, variable string) string {
+	for _, section := range c {
+		if section.name == name && section.subsection == subsection {
+			if values := section.values[variable]; len(values) > 0 {
+				return values[0]
+			}
+		}
+	}
+	return ""
+}
+
+// Returns the subsections of the sections with the name, in their order in the file, such as the names of the remotes.
+func (c config) subsections(name string) []string {
+	var subsections []string
+	visited := make(map[string]bool)
+	for _, section := range c {
+		if section.name == name && !visited[section.subsection] {
+			visited[section.subsection] = true
+			subsections = append(subsections, section.subsection)
+		}
+	}
+	return subsections
+}
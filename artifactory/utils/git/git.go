@@ -1,33 +1,65 @@
 package git
 
 import (
-	"bufio"
 	"errors"
-	clientutils "github.com/jfrog/jfrog-client-go/utils"
-	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
+
+	clientutils "github.com/jfrog/jfrog-client-go/utils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
+// The remote used when no remote is selected, and the current branch does not track a remote.
+const DefaultRemote = "origin"
+
+// The details of a submodule, as checked out in the work tree.
+type Submodule struct {
+	Path     string
+	Url      string
+	Revision string
+}
+
+// Reads the git metadata of a work tree, without running git.
 type manager struct {
-	path     string
-	err      error
-	revision string
-	url      string
+	// The work tree, which includes the .git dir, or the .git file of a worktree or submodule.
+	path       string
+	remote     string
+	err        error
+	revision   string
+	url        string
+	branch     string
+	tags       []string
+	message    string
+	author     string
+	submodules []Submodule
 }
 
 func NewManager(path string) *manager {
-	dotGitPath := filepath.Join(path, ".git")
-	return &manager{path: dotGitPath}
+	return &manager{path: path}
+}
+
+// Selects the remote whose URL is read. By default, the remote tracked by the current branch is read,
+// or the origin remote, or the first remote.
+func (m *manager) SetRemote(remote string) *manager {
+	m.remote = remote
+	return m
 }
 
 func (m *manager) ReadConfig() error {
 	if m.path == "" {
 		return errorutils.CheckError(errors.New(".git path must be defined."))
 	}
-	m.readRevision()
-	m.readUrl()
+	repo, err := openRepository(m.path)
+	if err != nil {
+		return err
+	}
+	m.readRevision(repo)
+	m.readUrl(repo)
+	m.readCommit(repo)
+	m.readSubmodules()
 	return m.err
 }
 
@@ -39,116 +71,236 @@ func (m *manager) GetRevision() string {
 	return m.revision
 }
 
-func (m *manager) readUrl() {
-	if m.err != nil {
-		return
+// Returns the checked out branch, or an empty string if HEAD is detached.
+func (m *manager) GetBranch() string {
+	return m.branch
+}
+
+// Returns the tags which point to the checked out commit.
+func (m *manager) GetTags() []string {
+	return m.tags
+}
+
+func (m *manager) GetMessage() string {
+	return m.message
+}
+
+// Returns the author of the checked out commit, as "name <email>".
+func (m *manager) GetAuthor() string {
+	return m.author
+}
+
+func (m *manager) GetSubmodules() []Submodule {
+	return m.submodules
+}
+
+// Returns the work tree which includes the dir, by looking for .git in the dir and in its parents.
+// The .git of worktrees and submodules is a file, rather than a dir.
+func FindWorkTree(dir string) (workTree string, exists bool, err error) {
+	workTree, err = filepath.Abs(dir)
+	if err != nil {
+		return "", false, errorutils.CheckError(err)
 	}
-	dotGitPath := filepath.Join(m.path, "config")
-	file, err := os.Open(dotGitPath)
-	if errorutils.CheckError(err) != nil {
-		m.err = err
-		return
+	for {
+		if _, err = os.Stat(filepath.Join(workTree, ".git")); err == nil {
+			return workTree, true, nil
+		}
+		parent := filepath.Dir(workTree)
+		if parent == workTree {
+			return "", false, nil
+		}
+		workTree = parent
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var IsNextLineUrl bool
-	var originUrl string
-	for scanner.Scan() {
-		if IsNextLineUrl {
-			text := scanner.Text()
-			strings.HasPrefix(text, "url")
-			originUrl = strings.TrimSpace(strings.SplitAfter(text, "=")[1])
-			break
+}
+
+// A git repository, as seen from one of its work trees.
+type repository struct {
+	// The git dir of the work tree, which includes its HEAD.
+	gitDir string
+	// The git dir shared by all the worktrees of the repository, which includes the config, the objects and the refs.
+	commonDir  string
+	packedRefs map[string]packedRef
+}
+
+// Opens the repository of a work tree. The .git of the work tree is either the git dir, or a file which points to it
+// in the form of "gitdir: <path>", in worktrees and submodules. The git dir of a worktree points to the common dir.
+func openRepository(workTree string) (*repository, error) {
+	dotGit := filepath.Join(workTree, ".git")
+	info, err := os.Stat(dotGit)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	repo := &repository{gitDir: dotGit}
+	if !info.IsDir() {
+		if repo.gitDir, err = readPathFile(dotGit, "gitdir:"); err != nil {
+			return nil, err
 		}
-		if scanner.Text() == "[remote \"origin\"]" {
-			IsNextLineUrl = true
+	}
+	repo.commonDir = repo.gitDir
+	if _, err = os.Stat(filepath.Join(repo.gitDir, "commondir")); err == nil {
+		if repo.commonDir, err = readPathFile(filepath.Join(repo.gitDir, "commondir"), ""); err != nil {
+			return nil, err
 		}
 	}
-	if err := scanner.Err(); err != nil {
-		errorutils.CheckError(err)
+	return repo, nil
+}
+
+// Reads a file which includes a path, after the prefix. A relative path is relative to the dir of the file.
+func readPathFile(path, prefix string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	target := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(target, prefix) {
+		return "", errorutils.CheckError(errors.New("Unexpected content in " + path + ": " + target))
+	}
+	target = filepath.FromSlash(strings.TrimSpace(strings.TrimPrefix(target, prefix)))
+	if !filepath.IsAbs(target) {
+		target = filepath.Join(filepath.Dir(path), target)
+	}
+	return target, nil
+}
+
+func (r *repository) objectsDir() string {
+	return filepath.Join(r.commonDir, "objects")
+}
+
+func (m *manager) readRevision(repo *repository) {
+	if m.err != nil {
+		return
+	}
+	head, symbolic, err := repo.readRef("HEAD")
+	if err != nil {
 		m.err = err
 		return
 	}
-	if !strings.HasSuffix(originUrl, ".git") {
-		originUrl += ".git"
+	if !symbolic {
+		// A detached HEAD.
+		m.revision = head
+	} else {
+		m.branch = strings.TrimPrefix(head, "refs/heads/")
+		if m.revision, m.err = repo.resolveRef(head); m.err != nil {
+			return
+		}
 	}
-	m.url = originUrl
+	m.tags, m.err = repo.getTags(m.revision)
+}
 
-	// Mask url if required
-	regExp, err := clientutils.GetRegExp(clientutils.CredentialsInUrlRegexp)
+func (m *manager) readUrl(repo *repository) {
+	if m.err != nil {
+		return
+	}
+	gitConfig, err := readConfig(filepath.Join(repo.commonDir, "config"))
 	if err != nil {
 		m.err = err
 		return
 	}
-	matchedResult := regExp.FindString(originUrl)
-	if matchedResult == "" {
+	remote := m.remote
+	if remote == "" {
+		remote = getDefaultRemote(gitConfig, m.branch)
+	}
+	// The push URL is used only if the remote has no fetch URL.
+	originUrl := gitConfig.get("remote", remote, "url")
+	if originUrl == "" {
+		originUrl = gitConfig.get("remote", remote, "pushurl")
+	}
+	if originUrl == "" {
+		if m.remote != "" {
+			m.err = errorutils.CheckError(errors.New("The git remote " + m.remote + " does not exist in " + m.path + "."))
+		}
 		return
 	}
-	m.url = clientutils.MaskCredentials(originUrl, matchedResult)
+	m.url = normalizeUrl(originUrl)
 }
 
-func (m *manager) getRevisionOrBranchPath() (revision, refUrl string, err error) {
-	dotGitPath := filepath.Join(m.path, "HEAD")
-	file, e := os.Open(dotGitPath)
-	if errorutils.CheckError(e) != nil {
-		err = e
-		return
+// Returns the remote tracked by the branch. If it tracks no remote, the origin remote is returned if it exists, or else the first remote.
+func getDefaultRemote(gitConfig config, branch string) string {
+	if remote := gitConfig.get("branch", branch, "remote"); branch != "" && remote != "" && remote != "." {
+		return remote
 	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		text := scanner.Text()
-		if strings.HasPrefix(text, "ref") {
-			refUrl = strings.TrimSpace(strings.SplitAfter(text, ":")[1])
-			break
+	remotes := gitConfig.subsections("remote")
+	for _, remote := range remotes {
+		if remote == DefaultRemote {
+			return remote
 		}
-		revision = text
 	}
-	if err = scanner.Err(); err != nil {
-		errorutils.CheckError(err)
+	if len(remotes) > 0 {
+		return remotes[0]
 	}
-	return
+	return DefaultRemote
 }
 
-func (m *manager) readRevision() {
-	if m.err != nil {
-		return
+// Adds the .git suffix to the URL if it is missing, and masks the credentials in it.
+func normalizeUrl(url string) string {
+	if !strings.HasSuffix(url, ".git") {
+		url += ".git"
 	}
-	// This function will either return the revision or the branch ref:
-	revision, ref, err := m.getRevisionOrBranchPath()
+	regExp, err := clientutils.GetRegExp(clientutils.CredentialsInUrlRegexp)
 	if err != nil {
-		m.err = err
+		return url
+	}
+	matchedResult := regExp.FindString(url)
+	if matchedResult == "" {
+		return url
+	}
+	return clientutils.MaskCredentials(url, matchedResult)
+}
+
+func (m *manager) readCommit(repo *repository) {
+	if m.err != nil {
 		return
 	}
-	// If the revision was returned, then we're done:
-	if revision != "" {
-		m.revision = revision
+	objectType, content, err := readObject(repo.objectsDir(), m.revision)
+	if err == errObjectNotFound || (err == nil && objectType != "commit") {
+		// The commit may be missing in a shallow or partial clone.
+		log.Debug("The details of git commit", m.revision, "were not found.")
 		return
 	}
-
-	// Since the revision was not returned, then we'll fetch it, by using the ref:
-	dotGitPath := filepath.Join(m.path, ref)
-	file, err := os.Open(dotGitPath)
 	if err != nil {
 		m.err = err
 		return
 	}
-	defer file.Close()
+	commit := parseCommit(content)
+	m.message = commit.message
+	m.author = commit.author
+}
 
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		text := scanner.Text()
-		revision = strings.TrimSpace(text)
-		break
+// Reads the submodules listed in .gitmodules, which are checked out in the work tree.
+func (m *manager) readSubmodules() {
+	if m.err != nil {
+		return
 	}
-	if err := scanner.Err(); err != nil {
-		errorutils.CheckError(err)
+	gitModules, err := readConfig(filepath.Join(m.path, ".gitmodules"))
+	if err != nil {
 		m.err = err
 		return
 	}
-
-	m.revision = revision
-	return
+	for _, name := range gitModules.subsections("submodule") {
+		submodulePath := gitModules.get("submodule", name, "path")
+		if submodulePath == "" {
+			continue
+		}
+		workTree := filepath.Join(m.path, filepath.FromSlash(submodulePath))
+		if _, err := os.Stat(filepath.Join(workTree, ".git")); err != nil {
+			log.Debug("Skipping git submodule", submodulePath+", which is not checked out.")
+			continue
+		}
+		repo, err := openRepository(workTree)
+		if err != nil {
+			m.err = err
+			return
+		}
+		submodule := &manager{path: workTree}
+		submodule.readRevision(repo)
+		if submodule.err != nil {
+			m.err = submodule.err
+			return
+		}
+		submoduleUrl := gitModules.get("submodule", name, "url")
+		if submoduleUrl != "" {
+			submoduleUrl = normalizeUrl(submoduleUrl)
+		}
+		m.submodules = append(m.submodules, Submodule{Path: submodulePath, Url: submoduleUrl, Revision: submodule.revision})
+	}
 }
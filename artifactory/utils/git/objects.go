@@ -0,0 +1,302 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The types of the objects in pack files.
+const (
+	packObjectCommit   = 1
+	packObjectTree     = 2
+	packObjectBlob     = 3
+	packObjectTag      = 4
+	packObjectOfsDelta = 6
+	packObjectRefDelta = 7
+)
+
+var packObjectTypes = map[byte]string{packObjectCommit: "commit", packObjectTree: "tree", packObjectBlob: "blob", packObjectTag: "tag"}
+
+var errObjectNotFound = errors.New("object not found")
+
+var errInvalidDelta = errors.New("Invalid git delta object")
+
+// Reads the type and content of an object from the objects dir, either from its loose object file or from a pack file.
+// If the object does not exist, errObjectNotFound is returned.
+func readObject(objectsDir, sha string) (objectType string, content []byte, err error) {
+	if len(sha) != 40 {
+		return "", nil, errObjectNotFound
+	}
+	objectType, content, err = readLooseObject(filepath.Join(objectsDir, sha[:2], sha[2:]))
+	if err != errObjectNotFound {
+		return
+	}
+	idxFiles, err := filepath.Glob(filepath.Join(objectsDir, "pack", "*.idx"))
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	for _, idxFile := range idxFiles {
+		offset, found, err := findPackOffset(idxFile, sha)
+		if err != nil {
+			return "", nil, err
+		}
+		if found {
+			return readPackedObject(objectsDir, strings.TrimSuffix(idxFile, ".idx")+".pack", offset)
+		}
+	}
+	return "", nil, errObjectNotFound
+}
+
+// A loose object is a zlib compressed "<type> <size>\0<content>".
+func readLooseObject(path string) (string, []byte, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return "", nil, errObjectNotFound
+	}
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	defer file.Close()
+	reader, err := zlib.NewReader(file)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	defer reader.Close()
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	header := bytes.IndexByte(data, 0)
+	if header < 0 {
+		return "", nil, errorutils.CheckError(errors.New("Invalid git object " + path))
+	}
+	objectType := strings.SplitN(string(data[:header]), " ", 2)[0]
+	return objectType, data[header+1:], nil
+}
+
+// Returns the offset of the object in the pack file of a version 2 pack index.
+func findPackOffset(idxFile, sha string) (int64, bool, error) {
+	data, err := ioutil.ReadFile(idxFile)
+	if err != nil {
+		return 0, false, errorutils.CheckError(err)
+	}
+	if len(data) < 8+256*4 || !bytes.Equal(data[:4], []byte{0xff, 't', 'O', 'c'}) || binary.BigEndian.Uint32(data[4:8]) != 2 {
+		return 0, false, errorutils.CheckError(errors.New("Unsupported git pack index " + idxFile))
+	}
+	shaBytes, err := hex.DecodeString(sha)
+	if err != nil {
+		return 0, false, nil
+	}
+	fanout := data[8 : 8+256*4]
+	count := int(binary.BigEndian.Uint32(fanout[255*4:]))
+	shasStart := 8 + 256*4
+	crcsStart := shasStart + count*20
+	offsetsStart := crcsStart + count*4
+	largeOffsetsStart := offsetsStart + count*4
+	if len(data) < largeOffsetsStart {
+		return 0, false, errorutils.CheckError(errors.New("Invalid git pack index " + idxFile))
+	}
+	i := sort.Search(count, func(i int) bool {
+		return bytes.Compare(data[shasStart+i*20:shasStart+(i+1)*20], shaBytes) >= 0
+	})
+	if i == count || !bytes.Equal(data[shasStart+i*20:shasStart+(i+1)*20], shaBytes) {
+		return 0, false, nil
+	}
+	offset := binary.BigEndian.Uint32(data[offsetsStart+i*4:])
+	if offset&0x80000000 == 0 {
+		return int64(offset), true, nil
+	}
+	// Offsets of large pack files are stored in a separate table of 8 byte offsets.
+	largeOffset := largeOffsetsStart + int(offset&0x7fffffff)*8
+	if len(data) < largeOffset+8 {
+		return 0, false, errorutils.CheckError(errors.New("Invalid git pack index " + idxFile))
+	}
+	return int64(binary.BigEndian.Uint64(data[largeOffset:])), true, nil
+}
+
+// Reads an object from a pack file. Deltified objects are applied to their base objects.
+func readPackedObject(objectsDir, packFile string, offset int64) (string, []byte, error) {
+	file, err := os.Open(packFile)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	defer file.Close()
+	return readPackedObjectAt(objectsDir, file, offset)
+}
+
+func readPackedObjectAt(objectsDir string, file *os.File, offset int64) (string, []byte, error) {
+	reader := bufio.NewReader(io.NewSectionReader(file, offset, 1<<62))
+	c, err := reader.ReadByte()
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	objectType := (c >> 4) & 7
+	// The size is not needed, since the compressed data ends with the object.
+	for c&0x80 != 0 {
+		if c, err = reader.ReadByte(); err != nil {
+			return "", nil, errorutils.CheckError(err)
+		}
+	}
+	var baseType string
+	var base []byte
+	switch objectType {
+	case packObjectOfsDelta:
+		// The base object is at a negative offset, encoded with an extra 1 added to each continuation byte.
+		if c, err = reader.ReadByte(); err != nil {
+			return "", nil, errorutils.CheckError(err)
+		}
+		baseOffset := int64(c & 0x7f)
+		for c&0x80 != 0 {
+			if c, err = reader.ReadByte(); err != nil {
+				return "", nil, errorutils.CheckError(err)
+			}
+			baseOffset = ((baseOffset + 1) << 7) | int64(c&0x7f)
+		}
+		if baseType, base, err = readPackedObjectAt(objectsDir, file, offset-baseOffset); err != nil {
+			return "", nil, err
+		}
+	case packObjectRefDelta:
+		baseSha := make([]byte, 20)
+		if _, err = io.ReadFull(reader, baseSha); err != nil {
+			return "", nil, errorutils.CheckError(err)
+		}
+		if baseType, base, err = readObject(objectsDir, hex.EncodeToString(baseSha)); err != nil {
+			return "", nil, err
+		}
+	default:
+		if packObjectTypes[objectType] == "" {
+			return "", nil, errorutils.CheckError(fmt.Errorf("Unsupported git pack object type %d in %s", objectType, file.Name()))
+		}
+	}
+	zlibReader, err := zlib.NewReader(reader)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	defer zlibReader.Close()
+	data, err := ioutil.ReadAll(zlibReader)
+	if err != nil {
+		return "", nil, errorutils.CheckError(err)
+	}
+	if base == nil {
+		return packObjectTypes[objectType], data, nil
+	}
+	content, err := applyDelta(base, data)
+	return baseType, content, err
+}
+
+// Applies a git delta, which is a list of instructions to copy ranges of the base object or to insert new data.
+func applyDelta(base, delta []byte) ([]byte, error) {
+	readSize := func() (int, bool) {
+		size, shift := 0, uint(0)
+		for len(delta) > 0 {
+			c := delta[0]
+			delta = delta[1:]
+			size |= int(c&0x7f) << shift
+			shift += 7
+			if c&0x80 == 0 {
+				return size, true
+			}
+		}
+		return 0, false
+	}
+	if _, ok := readSize(); !ok {
+		return nil, errorutils.CheckError(errInvalidDelta)
+	}
+	resultSize, ok := readSize()
+	if !ok {
+		return nil, errorutils.CheckError(errInvalidDelta)
+	}
+	result := make([]byte, 0, resultSize)
+	for len(delta) > 0 {
+		c := delta[0]
+		delta = delta[1:]
+		if c&0x80 == 0 {
+			// Insert the next c bytes.
+			if c == 0 || int(c) > len(delta) {
+				return nil, errorutils.CheckError(errInvalidDelta)
+			}
+			result = append(result, delta[:c]...)
+			delta = delta[c:]
+			continue
+		}
+		// Copy from the base. The bits of c mark which bytes of the offset and size follow.
+		var copyOffset, copySize int
+		for i := uint(0); i < 7; i++ {
+			if c&(1<<i) == 0 {
+				continue
+			}
+			if len(delta) == 0 {
+				return nil, errorutils.CheckError(errInvalidDelta)
+			}
+			if i < 4 {
+				copyOffset |= int(delta[0]) << (8 * i)
+			} else {
+				copySize |= int(delta[0]) << (8 * (i - 4))
+			}
+			delta = delta[1:]
+		}
+		if copySize == 0 {
+			copySize = 0x10000
+		}
+		if copyOffset+copySize > len(base) {
+			return nil, errorutils.CheckError(errInvalidDelta)
+		}
+		result = append(result, base[copyOffset:copyOffset+copySize]...)
+	}
+	if len(result) != resultSize {
+		return nil, errorutils.CheckError(errInvalidDelta)
+	}
+	return result, nil
+}
+
+type commit struct {
+	author  string
+	message string
+}
+
+// Parses the author and message of a commit object.
+func parseCommit(content []byte) commit {
+	var result commit
+	headers, message := string(content), ""
+	if i := strings.Index(headers, "\n\n"); i >= 0 {
+		headers, message = headers[:i], headers[i+2:]
+	}
+	for _, line := range strings.Split(headers, "\n") {
+		if strings.HasPrefix(line, "author ") {
+			// The author is followed by the timestamp and the timezone: "author Name <email> 1577836800 +0200".
+			author := strings.TrimPrefix(line, "author ")
+			if i := strings.LastIndex(author, ">"); i >= 0 {
+				author = author[:i+1]
+			}
+			result.author = author
+		}
+	}
+	result.message = strings.TrimSpace(message)
+	return result
+}
+
+// Returns the object an annotated tag points to.
+func parseTagObject(content []byte) string {
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(line, "object ") {
+			return strings.TrimSpace(strings.TrimPrefix(line, "object "))
+		}
+		if line == "" {
+			break
+		}
+	}
+	return ""
+}
@@ -0,0 +1,153 @@
+package git
+
+import (
+	"bufio"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+)
+
+// The maximal number of symbolic refs followed when resolving a ref, to avoid running in circles.
+const maxSymbolicRefs = 10
+
+// A reference in packed-refs. Annotated tags are followed by the commit they point to.
+type packedRef struct {
+	sha    string
+	peeled string
+}
+
+// Reads the target of a ref, which is either a SHA-1 or another ref.
+// Per worktree refs, such as HEAD, are in the git dir, and the other refs are in the common dir or in packed-refs.
+func (r *repository) readRef(name string) (target string, symbolic bool, err error) {
+	for _, dir := range []string{r.gitDir, r.commonDir} {
+		content, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", false, errorutils.CheckError(err)
+		}
+		target = strings.TrimSpace(string(content))
+		if strings.HasPrefix(target, "ref:") {
+			return strings.TrimSpace(strings.TrimPrefix(target, "ref:")), true, nil
+		}
+		return target, false, nil
+	}
+	packedRefs, err := r.getPackedRefs()
+	if err != nil {
+		return "", false, err
+	}
+	if ref, exists := packedRefs[name]; exists {
+		return ref.sha, false, nil
+	}
+	return "", false, errorutils.CheckError(errors.New("The git ref " + name + " does not exist."))
+}
+
+// Resolves a ref to a SHA-1, following symbolic refs.
+func (r *repository) resolveRef(name string) (string, error) {
+	for i := 0; i < maxSymbolicRefs; i++ {
+		target, symbolic, err := r.readRef(name)
+		if err != nil || !symbolic {
+			return target, err
+		}
+		name = target
+	}
+	return "", errorutils.CheckError(errors.New("Too many levels of symbolic git refs, while resolving " + name + "."))
+}
+
+func (r *repository) getPackedRefs() (map[string]packedRef, error) {
+	if r.packedRefs != nil {
+		return r.packedRefs, nil
+	}
+	r.packedRefs = make(map[string]packedRef)
+	file, err := os.Open(filepath.Join(r.commonDir, "packed-refs"))
+	if os.IsNotExist(err) {
+		return r.packedRefs, nil
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	defer file.Close()
+	var lastRef string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, "^"):
+			// The commit the previous annotated tag points to.
+			if ref, exists := r.packedRefs[lastRef]; exists {
+				ref.peeled = strings.TrimPrefix(line, "^")
+				r.packedRefs[lastRef] = ref
+			}
+		default:
+			fields := strings.Fields(line)
+			if len(fields) == 2 {
+				lastRef = fields[1]
+				r.packedRefs[lastRef] = packedRef{sha: fields[0]}
+			}
+		}
+	}
+	return r.packedRefs, errorutils.CheckError(scanner.Err())
+}
+
+// Returns the names of the tags which point to the commit, sorted by name.
+func (r *repository) getTags(revision string) ([]string, error) {
+	tags := make(map[string]bool)
+	packedRefs, err := r.getPackedRefs()
+	if err != nil {
+		return nil, err
+	}
+	for name, ref := range packedRefs {
+		if strings.HasPrefix(name, "refs/tags/") && (ref.sha == revision || ref.peeled == revision) {
+			tags[strings.TrimPrefix(name, "refs/tags/")] = true
+		}
+	}
+	// Loose tags override packed tags with the same name.
+	tagsDir := filepath.Join(r.commonDir, "refs", "tags")
+	err = filepath.Walk(tagsDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		relativePath, err := filepath.Rel(tagsDir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(relativePath)
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		tags[name] = r.peel(strings.TrimSpace(string(content))) == revision
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errorutils.CheckError(err)
+	}
+	var result []string
+	for name, matches := range tags {
+		if matches {
+			result = append(result, name)
+		}
+	}
+	sort.Strings(result)
+	return result, nil
+}
+
+// Returns the commit an annotated tag object points to. Any other object is returned as is.
+func (r *repository) peel(sha string) string {
+	for i := 0; i < maxSymbolicRefs; i++ {
+		objectType, content, err := readObject(r.objectsDir(), sha)
+		if err != nil || objectType != "tag" {
+			return sha
+		}
+		sha = parseTagObject(content)
+	}
+	return sha
+}
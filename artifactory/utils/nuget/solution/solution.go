@@ -3,6 +3,7 @@ package solution
 import (
 	"encoding/json"
 	"errors"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils/dependenciestree"
 	"github.com/jfrog/jfrog-cli-go/artifactory/utils/nuget/solution/project"
 	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
 	"github.com/jfrog/jfrog-client-go/utils"
@@ -45,6 +46,10 @@ func (solution *solution) BuildInfo(module string) (*buildinfo.BuildInfo, error)
 		if err != nil {
 			return nil, err
 		}
+		childrenMap, err := project.Extractor().ChildrenMap()
+		if err != nil {
+			return nil, err
+		}
 		var projectDependencies []buildinfo.Dependency
 
 		for _, dep := range dependencies {
@@ -54,6 +59,8 @@ func (solution *solution) BuildInfo(module string) (*buildinfo.BuildInfo, error)
 			module = project.Name()
 		}
 		module := buildinfo.Module{Id: module, Dependencies: projectDependencies}
+		// Keep the package type of the dependencies and their dependency graph, which are used when exporting an SBOM of the build.
+		module.Properties = dependenciestree.CreateDependenciesProperties("nuget", dependencies, childrenMap)
 		modules = append(modules, module)
 	}
 	buildInfo.Modules = modules
@@ -137,6 +137,20 @@ func BuildPipDependencyMap(pythonExecPath string) (map[string]pipDependencyPacka
 	return parsePipDependencyMapOutput(data)
 }
 
+// Return the dependencies of each pip package installed in the environment, by the keys of the packages.
+// pythonExecPath - Execution path python.
+func BuildPipChildrenMap(pythonExecPath string) (map[string][]string, error) {
+	environmentPackages, err := BuildPipDependencyMap(pythonExecPath)
+	if err != nil {
+		return nil, err
+	}
+	childrenMap := make(map[string][]string, len(environmentPackages))
+	for key, pkg := range environmentPackages {
+		childrenMap[key] = pkg.getDependencies()
+	}
+	return childrenMap, nil
+}
+
 // Parse pip-dependency-map raw output to dependencies map.
 func parsePipDependencyMapOutput(data []byte) (map[string]pipDependencyPackage, error) {
 	// Parse into array.
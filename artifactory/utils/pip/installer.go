@@ -3,7 +3,9 @@ package pip
 import (
 	"fmt"
 	gofrogcmd "github.com/jfrog/gofrog/io"
+	"github.com/jfrog/jfrog-cli-go/artifactory/utils"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
 	"github.com/jfrog/jfrog-client-go/artifactory/auth"
 	clientutils "github.com/jfrog/jfrog-client-go/utils"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
@@ -21,8 +23,19 @@ type PipInstaller struct {
 }
 
 func (pi *PipInstaller) Install() error {
+	// Resolve the packages through the download cache, if enabled.
+	rtDetails := pi.RtDetails
+	if downloadcache.IsEnabled(false) {
+		proxy, proxyDetails, err := utils.StartDownloadCacheProxy(pi.RtDetails)
+		if err != nil {
+			return err
+		}
+		defer proxy.Close()
+		rtDetails = proxyDetails
+	}
+
 	// Prepare for running.
-	pipExecutablePath, pipIndexUrl, err := pi.prepare()
+	pipExecutablePath, pipIndexUrl, err := pi.prepare(rtDetails)
 	if err != nil {
 		return err
 	}
@@ -36,7 +49,7 @@ func (pi *PipInstaller) Install() error {
 	return nil
 }
 
-func (pi *PipInstaller) prepare() (pipExecutablePath, pipIndexUrl string, err error) {
+func (pi *PipInstaller) prepare(rtDetails *config.ArtifactoryDetails) (pipExecutablePath, pipIndexUrl string, err error) {
 	log.Debug("Preparing prerequisites.")
 
 	pipExecutablePath, err = GetExecutablePath("pip")
@@ -44,7 +57,7 @@ func (pi *PipInstaller) prepare() (pipExecutablePath, pipIndexUrl string, err er
 		return
 	}
 
-	pipIndexUrl, err = getArtifactoryUrlWithCredentials(pi.RtDetails, pi.Repository)
+	pipIndexUrl, err = getArtifactoryUrlWithCredentials(rtDetails, pi.Repository)
 	if err != nil {
 		return
 	}
@@ -0,0 +1,200 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"golang.org/x/crypto/openpgp"
+)
+
+// Reads the private key used for signing from an armored or binary GPG keyring file.
+// If keyId is set, the key whose ID or fingerprint ends with it is used. Otherwise, the keyring must include a single private key.
+// An encrypted key is decrypted using the passphrase in the JFROG_CLI_SIGNING_PASSPHRASE environment variable.
+func LoadSigningKey(keyringPath, keyId string) (*openpgp.Entity, error) {
+	keyring, err := readKeyring(keyringPath)
+	if err != nil {
+		return nil, err
+	}
+	var signers []*openpgp.Entity
+	for _, entity := range keyring {
+		if entity.PrivateKey != nil && matchesKeyId(entity, keyId) {
+			signers = append(signers, entity)
+		}
+	}
+	switch {
+	case len(signers) == 0 && keyId != "":
+		return nil, errorutils.CheckError(errors.New("No private key with ID " + keyId + " was found in " + keyringPath))
+	case len(signers) == 0:
+		return nil, errorutils.CheckError(errors.New("No private key was found in " + keyringPath))
+	case len(signers) > 1:
+		return nil, errorutils.CheckError(errors.New("The keyring " + keyringPath + " includes several private keys. Please specify the ID of the signing key."))
+	}
+	signer := signers[0]
+	if err = decryptSigningKey(signer); err != nil {
+		return nil, err
+	}
+	return signer, nil
+}
+
+// Reads a public or private GPG keyring file, in the armored or binary format.
+func readKeyring(keyringPath string) (openpgp.EntityList, error) {
+	content, err := ioutil.ReadFile(keyringPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	keyring, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(content))
+	if err != nil {
+		keyring, err = openpgp.ReadKeyRing(bytes.NewReader(content))
+	}
+	if err != nil {
+		return nil, errorutils.CheckError(errors.New("Failed reading the GPG keyring " + keyringPath + ": " + err.Error()))
+	}
+	return keyring, nil
+}
+
+func matchesKeyId(entity *openpgp.Entity, keyId string) bool {
+	if keyId == "" {
+		return true
+	}
+	keyId = strings.ToUpper(strings.TrimPrefix(keyId, "0x"))
+	fingerprint := strings.ToUpper(fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint))
+	return strings.HasSuffix(fingerprint, keyId)
+}
+
+func decryptSigningKey(signer *openpgp.Entity) error {
+	passphrase := []byte(os.Getenv(cliutils.SigningPassphrase))
+	if signer.PrivateKey.Encrypted {
+		if len(passphrase) == 0 {
+			return errorutils.CheckError(errors.New("The signing key is encrypted. Please set its passphrase in the " + cliutils.SigningPassphrase + " environment variable."))
+		}
+		if err := signer.PrivateKey.Decrypt(passphrase); err != nil {
+			return errorutils.CheckError(errors.New("Failed decrypting the signing key: " + err.Error()))
+		}
+	}
+	for _, subkey := range signer.Subkeys {
+		if subkey.PrivateKey != nil && subkey.PrivateKey.Encrypted {
+			if err := subkey.PrivateKey.Decrypt(passphrase); err != nil {
+				return errorutils.CheckError(errors.New("Failed decrypting the signing subkey: " + err.Error()))
+			}
+		}
+	}
+	return nil
+}
+
+// Writes an armored detached GPG signature of the content.
+func SignDetached(signer *openpgp.Entity, content io.Reader, signature io.Writer) error {
+	return errorutils.CheckError(openpgp.ArmoredDetachSign(signature, signer, content, nil))
+}
+
+// The extensions of the detached signature files, by the type of the signing key.
+const (
+	PgpSignatureExtension     = ".asc"
+	Ed25519SignatureExtension = ".sig"
+)
+
+// Creates detached signatures, using a GPG or an ed25519 private key.
+type Signer interface {
+	Sign(content []byte) ([]byte, error)
+	// The extension of the signature files, which identifies the type of the key.
+	SignatureExtension() string
+}
+
+// Reads a private key used for signing. An ed25519 key is read from a PKCS #8 PEM file, such as the one created by
+// "openssl genpkey -algorithm ed25519". Any other file is read as a GPG keyring, as in LoadSigningKey.
+func LoadSigner(keyPath, keyId string) (Signer, error) {
+	content, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	if block, _ := pem.Decode(content); block != nil && block.Type == "PRIVATE KEY" {
+		if keyId != "" {
+			return nil, errorutils.CheckError(errors.New("A key ID can only be specified with a GPG keyring, while " + keyPath + " is an ed25519 key."))
+		}
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, errorutils.CheckError(errors.New("Failed reading the private key " + keyPath + ": " + err.Error()))
+		}
+		ed25519Key, ok := key.(ed25519.PrivateKey)
+		if !ok {
+			return nil, errorutils.CheckError(errors.New("The private key " + keyPath + " is not an ed25519 key."))
+		}
+		return &ed25519Signer{key: ed25519Key}, nil
+	}
+	entity, err := LoadSigningKey(keyPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+	return &pgpSigner{entity: entity}, nil
+}
+
+type pgpSigner struct {
+	entity *openpgp.Entity
+}
+
+func (signer *pgpSigner) Sign(content []byte) ([]byte, error) {
+	var signature bytes.Buffer
+	if err := SignDetached(signer.entity, bytes.NewReader(content), &signature); err != nil {
+		return nil, err
+	}
+	return signature.Bytes(), nil
+}
+
+func (signer *pgpSigner) SignatureExtension() string {
+	return PgpSignatureExtension
+}
+
+// The ed25519 signatures are base64 encoded.
+type ed25519Signer struct {
+	key ed25519.PrivateKey
+}
+
+func (signer *ed25519Signer) Sign(content []byte) ([]byte, error) {
+	return []byte(base64.StdEncoding.EncodeToString(ed25519.Sign(signer.key, content)) + "\n"), nil
+}
+
+func (signer *ed25519Signer) SignatureExtension() string {
+	return Ed25519SignatureExtension
+}
+
+// Verifies a detached signature of the content, created by a Signer. The public key is read from a PKIX PEM file of an ed25519 key,
+// such as the one created by "openssl pkey -pubout", or else from a GPG keyring, which may include several public keys.
+func VerifySignature(publicKeyPath string, content, signature []byte) error {
+	keyContent, err := ioutil.ReadFile(publicKeyPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	if block, _ := pem.Decode(keyContent); block != nil && block.Type == "PUBLIC KEY" {
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return errorutils.CheckError(errors.New("Failed reading the public key " + publicKeyPath + ": " + err.Error()))
+		}
+		ed25519Key, ok := key.(ed25519.PublicKey)
+		if !ok {
+			return errorutils.CheckError(errors.New("The public key " + publicKeyPath + " is not an ed25519 key."))
+		}
+		decodedSignature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+		if err != nil || !ed25519.Verify(ed25519Key, content, decodedSignature) {
+			return errorutils.CheckError(errors.New("The signature does not match the content and the public key " + publicKeyPath + "."))
+		}
+		return nil
+	}
+	keyring, err := readKeyring(publicKeyPath)
+	if err != nil {
+		return err
+	}
+	if _, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(content), bytes.NewReader(signature)); err != nil {
+		return errorutils.CheckError(errors.New("The signature does not match the content and the keyring " + publicKeyPath + ": " + err.Error()))
+	}
+	return nil
+}
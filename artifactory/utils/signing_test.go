@@ -0,0 +1,97 @@
+package utils
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// Signs the content with the private key, and verifies the signature with the public key, and with a modified content.
+func checkSignAndVerify(t *testing.T, privateKeyPath, publicKeyPath, expectedExtension string) {
+	signer, err := LoadSigner(privateKeyPath, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer.SignatureExtension() != expectedExtension {
+		t.Errorf("Expected the signature extension %s, got %s.", expectedExtension, signer.SignatureExtension())
+	}
+	content := []byte(`{"name":"build","number":"1"}`)
+	signature, err := signer.Sign(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifySignature(publicKeyPath, content, signature); err != nil {
+		t.Errorf("Expected a valid signature: %v", err)
+	}
+	if err = VerifySignature(publicKeyPath, []byte(`{"name":"build","number":"2"}`), signature); err == nil {
+		t.Error("Expected an invalid signature of a modified content.")
+	}
+}
+
+func TestSignAndVerifyEd25519(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "signing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyBytes, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyBytes, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyPath := filepath.Join(tempDir, "key.pem")
+	publicKeyPath := filepath.Join(tempDir, "key.pub.pem")
+	if err = ioutil.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateKeyBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(publicKeyPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	checkSignAndVerify(t, privateKeyPath, publicKeyPath, Ed25519SignatureExtension)
+	if _, err = LoadSigner(privateKeyPath, "ABCD1234"); err == nil {
+		t.Error("Expected an error for a key ID of an ed25519 key.")
+	}
+}
+
+func TestSignAndVerifyPgp(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "signing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyring, err := os.Create(filepath.Join(tempDir, "secring.gpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer privateKeyring.Close()
+	if err = entity.SerializePrivate(privateKeyring, nil); err != nil {
+		t.Fatal(err)
+	}
+	publicKeyring, err := os.Create(filepath.Join(tempDir, "pubring.gpg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer publicKeyring.Close()
+	if err = entity.Serialize(publicKeyring); err != nil {
+		t.Fatal(err)
+	}
+	checkSignAndVerify(t, privateKeyring.Name(), publicKeyring.Name(), PgpSignatureExtension)
+}
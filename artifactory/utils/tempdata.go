@@ -0,0 +1,167 @@
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+const XrayTempPath = "jfrog/xray/"
+
+// The default age after which the data kept in the temp dir between executions is removed.
+const DefaultTempDataExpiry = 7 * 24 * time.Hour
+
+// The expired temp data is removed automatically at most once in this interval.
+// The time of the last removal is the modification time of the marker file.
+const tempDataCleanupInterval = 24 * time.Hour
+const tempDataCleanupMarker = "jfrog/.tempdatacleanup"
+
+// The kinds of data kept in the temp dir between executions.
+const (
+	TempDataBuild      = "build"
+	TempDataProperties = "properties"
+	TempDataXray       = "xray"
+)
+
+// Data which JFrog CLI keeps in its temp dir between executions, and which is left behind if a pipeline fails,
+// such as the build-info data of a build which was not published or cleaned.
+type TempData struct {
+	Type string `json:"type,omitempty"`
+	// The build name and number of build-info data, or the file name of other data.
+	Name string `json:"name,omitempty"`
+	// The time in which the data was created. For build-info data, the time in which it started being collected.
+	Created time.Time `json:"created"`
+	Path    string    `json:"path,omitempty"`
+}
+
+// Returns the data kept in the temp dir between executions, sorted by creation time.
+func GetTempData() ([]TempData, error) {
+	return getTempData(cliutils.GetCliPersistentTempDirPath())
+}
+
+func getTempData(tempDir string) ([]TempData, error) {
+	localBuilds, err := getLocalBuilds(filepath.Join(tempDir, BuildTempPath))
+	if err != nil {
+		return nil, err
+	}
+	var tempData []TempData
+	for _, localBuild := range localBuilds {
+		tempData = append(tempData, TempData{Type: TempDataBuild, Name: localBuild.Name + "/" + localBuild.Number, Created: localBuild.Started, Path: localBuild.Dir})
+	}
+	for dataType, dataPath := range map[string]string{TempDataProperties: PROPERTIES_TEMP_PATH, TempDataXray: XrayTempPath} {
+		dataDir := filepath.Join(tempDir, dataPath)
+		files, err := ioutil.ReadDir(dataDir)
+		if err != nil && !os.IsNotExist(err) {
+			return nil, errorutils.CheckError(err)
+		}
+		for _, file := range files {
+			tempData = append(tempData, TempData{Type: dataType, Name: file.Name(), Created: file.ModTime(), Path: filepath.Join(dataDir, file.Name())})
+		}
+	}
+	sort.Slice(tempData, func(i, j int) bool {
+		return tempData[i].Created.Before(tempData[j].Created)
+	})
+	return tempData, nil
+}
+
+// Removes the data kept in the temp dir between executions, which was created before the specified time, and returns the removed data.
+func RemoveTempData(createdBefore time.Time) ([]TempData, error) {
+	return removeTempData(cliutils.GetCliPersistentTempDirPath(), createdBefore)
+}
+
+func removeTempData(tempDir string, createdBefore time.Time) ([]TempData, error) {
+	tempData, err := getTempData(tempDir)
+	if err != nil {
+		return nil, err
+	}
+	removed := []TempData{}
+	for _, data := range tempData {
+		if !data.Created.Before(createdBefore) {
+			continue
+		}
+		log.Debug("Removing", data.Type, "data", data.Path)
+		if err = os.RemoveAll(data.Path); err != nil {
+			return removed, errorutils.CheckError(err)
+		}
+		removed = append(removed, data)
+	}
+	return removed, nil
+}
+
+// Parses an age, such as 7d, 12h or 30m. Days are supported in addition to the units of time.ParseDuration.
+func ParseAge(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err == nil && days >= 0 {
+			return time.Duration(days) * 24 * time.Hour, nil
+		}
+	} else if age, err := time.ParseDuration(value); err == nil && age >= 0 {
+		return age, nil
+	}
+	return 0, errorutils.CheckError(errors.New("Invalid age " + value + ". The age should be a number followed by d, h, m or s, for example 7d or 12h."))
+}
+
+// Returns the age in its largest whole unit, such as 5m, 3h or 2d.
+func FormatAge(age time.Duration) string {
+	switch {
+	case age < time.Minute:
+		return strconv.Itoa(int(age/time.Second)) + "s"
+	case age < time.Hour:
+		return strconv.Itoa(int(age/time.Minute)) + "m"
+	case age < 24*time.Hour:
+		return strconv.Itoa(int(age/time.Hour)) + "h"
+	}
+	return strconv.Itoa(int(age/(24*time.Hour))) + "d"
+}
+
+// Returns the age after which the temp data is removed automatically, taken from the JFROG_CLI_TEMP_DATA_EXPIRY environment variable if set.
+// An age of 0 disables the automatic removal.
+func GetTempDataExpiry() (time.Duration, error) {
+	value := os.Getenv(cliutils.TempDataExpiry)
+	if value == "" {
+		return DefaultTempDataExpiry, nil
+	}
+	return ParseAge(value)
+}
+
+// Removes the expired temp data, if it was not removed in the last day.
+// Failing to remove it does not fail the running command, so errors are only logged.
+func RemoveExpiredTempData() {
+	if err := removeExpiredTempData(cliutils.GetCliPersistentTempDirPath(), time.Now()); err != nil {
+		log.Debug("Failed removing expired temp data:", err.Error())
+	}
+}
+
+func removeExpiredTempData(tempDir string, now time.Time) error {
+	expiry, err := GetTempDataExpiry()
+	if err != nil || expiry == 0 {
+		return err
+	}
+	markerPath := filepath.Join(tempDir, tempDataCleanupMarker)
+	if markerInfo, err := os.Stat(markerPath); err == nil && now.Sub(markerInfo.ModTime()) < tempDataCleanupInterval {
+		return nil
+	}
+	if err = os.MkdirAll(filepath.Dir(markerPath), 0777); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = ioutil.WriteFile(markerPath, []byte{}, 0600); err != nil {
+		return errorutils.CheckError(err)
+	}
+	if err = os.Chtimes(markerPath, now, now); err != nil {
+		return errorutils.CheckError(err)
+	}
+	removed, err := removeTempData(tempDir, now.Add(-expiry))
+	for _, data := range removed {
+		log.Info("Removed expired", data.Type, "data", data.Name+", created at", data.Created.Format(time.RFC3339)+".")
+	}
+	return err
+}
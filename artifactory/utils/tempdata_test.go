@@ -0,0 +1,140 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-client-go/artifactory/buildinfo"
+)
+
+// Creates build-info data, a properties file and an Xray updates dir in the temp dir, each created at the specified time.
+func createTempData(t *testing.T, tempDir, suffix string, created time.Time) {
+	buildDir := filepath.Join(tempDir, BuildTempPath, base64.StdEncoding.EncodeToString([]byte("build_"+suffix)), "partials")
+	if err := os.MkdirAll(buildDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	details, err := json.Marshal(buildinfo.General{Timestamp: created})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(buildDir, BuildInfoDetails), details, 0600); err != nil {
+		t.Fatal(err)
+	}
+	propertiesFile := filepath.Join(tempDir, PROPERTIES_TEMP_PATH, "properties"+suffix)
+	xrayDir := filepath.Join(tempDir, XrayTempPath, "xray"+suffix)
+	if err = os.MkdirAll(filepath.Dir(propertiesFile), 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(propertiesFile, []byte{}, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.MkdirAll(xrayDir, 0777); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{propertiesFile, xrayDir} {
+		if err = os.Chtimes(path, created, created); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func getTempDataNames(tempData []TempData) map[string]bool {
+	names := make(map[string]bool)
+	for _, data := range tempData {
+		names[data.Type+":"+data.Name] = true
+	}
+	return names
+}
+
+func TestRemoveTempData(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "temp-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	now := time.Now()
+	createTempData(t, tempDir, "old", now.Add(-10*24*time.Hour))
+	createTempData(t, tempDir, "new", now.Add(-time.Hour))
+
+	removed, err := removeTempData(tempDir, now.Add(-7*24*time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]bool{"build:build/old": true, "properties:propertiesold": true, "xray:xrayold": true}
+	if names := getTempDataNames(removed); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected the removed data to be %v, got %v.", expected, names)
+	}
+	remaining, err := getTempData(tempDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = map[string]bool{"build:build/new": true, "properties:propertiesnew": true, "xray:xraynew": true}
+	if names := getTempDataNames(remaining); !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected the remaining data to be %v, got %v.", expected, names)
+	}
+}
+
+func TestRemoveExpiredTempData(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "temp-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	now := time.Now()
+	createTempData(t, tempDir, "old", now.Add(-10*24*time.Hour))
+	if err = removeExpiredTempData(tempDir, now); err != nil {
+		t.Fatal(err)
+	}
+	if remaining, err := getTempData(tempDir); err != nil || len(remaining) != 0 {
+		t.Errorf("Expected the expired data to be removed, got %v, %v.", remaining, err)
+	}
+
+	// The expired data was removed less than a day ago, so it is not removed again.
+	createTempData(t, tempDir, "old", now.Add(-10*24*time.Hour))
+	if err = removeExpiredTempData(tempDir, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if remaining, err := getTempData(tempDir); err != nil || len(remaining) != 3 {
+		t.Errorf("Expected the expired data to be kept until the next day, got %v, %v.", remaining, err)
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	ages := map[string]time.Duration{"7d": 7 * 24 * time.Hour, "12h": 12 * time.Hour, "30m": 30 * time.Minute, "0": 0}
+	for value, expected := range ages {
+		age, err := ParseAge(value)
+		if err != nil {
+			t.Error(err)
+		} else if age != expected {
+			t.Errorf("Expected the age %s to be %v, got %v.", value, expected, age)
+		}
+	}
+	for _, value := range []string{"", "d", "-1d", "7 days"} {
+		if _, err := ParseAge(value); err == nil {
+			t.Errorf("Expected %q to be an invalid age.", value)
+		}
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	tests := []struct {
+		age      time.Duration
+		expected string
+	}{
+		{30 * time.Second, "30s"},
+		{5*time.Minute + 10*time.Second, "5m"},
+		{3*time.Hour + 59*time.Minute, "3h"},
+		{50 * time.Hour, "2d"},
+	}
+	for _, test := range tests {
+		if actual := FormatAge(test.age); actual != test.expected {
+			t.Errorf("Age %v: expected %s, got %s.", test.age, test.expected, actual)
+		}
+	}
+}
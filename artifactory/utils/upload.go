@@ -2,6 +2,7 @@ package utils
 
 import (
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
 	"github.com/jfrog/jfrog-client-go/artifactory"
 	"github.com/jfrog/jfrog-client-go/utils/io"
 )
@@ -19,6 +20,9 @@ func CreateUploadServiceManager(artDetails *config.ArtifactoryDetails, flags *Up
 		SetThreads(flags.Threads).
 		Build()
 
+	if flags.LimitRate > 0 {
+		progressBar = ratelimit.WrapProgress(progressBar, ratelimit.NewLimiter(flags.LimitRate))
+	}
 	return artifactory.NewWithProgress(&artAuth, servicesConfig, progressBar)
 }
 
@@ -29,4 +33,12 @@ type UploadConfiguration struct {
 	Symlink               bool
 	ExplodeArchive        bool
 	Retries               int
+	// Maximum transfer rate in bytes per second, shared by all threads. 0 means unlimited.
+	LimitRate int64
+	// If set, a detached .asc GPG signature is uploaded next to each artifact, signed by a key from this keyring file.
+	SigningKeyring string
+	// The ID of the signing key, if the keyring includes several private keys.
+	SigningKeyId string
+	// The checksum files (sha256 or md5) uploaded next to each artifact.
+	ChecksumFiles []string
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/jfrog/jfrog-cli-go/docs/common"
 	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
 	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
 	"github.com/jfrog/jfrog-client-go/bintray"
 	"github.com/jfrog/jfrog-client-go/bintray/auth"
 	"github.com/jfrog/jfrog-client-go/bintray/services"
@@ -65,7 +66,7 @@ func GetCommands() []cli.Command {
 			Usage:        uploaddocs.Description,
 			HelpName:     common.CreateUsage("bt upload", uploaddocs.Description, uploaddocs.Usage),
 			UsageText:    uploaddocs.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.LimitRateEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return upload(c)
@@ -78,7 +79,7 @@ func GetCommands() []cli.Command {
 			Usage:        downloadfile.Description,
 			HelpName:     common.CreateUsage("bt download-file", downloadfile.Description, downloadfile.Usage),
 			UsageText:    downloadfile.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.LimitRateEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return downloadFile(c)
@@ -91,7 +92,7 @@ func GetCommands() []cli.Command {
 			Usage:        downloadver.Description,
 			HelpName:     common.CreateUsage("bt download-ver", downloadver.Description, downloadver.Usage),
 			UsageText:    downloadver.Arguments,
-			ArgsUsage:    common.CreateEnvVars(),
+			ArgsUsage:    common.CreateEnvVars(common.LimitRateEnvVar),
 			BashComplete: common.CreateBashCompletionFunc(),
 			Action: func(c *cli.Context) error {
 				return downloadVersion(c)
@@ -341,11 +342,18 @@ func getConfigFlags() []cli.Flag {
 		},
 	}
 	flags = append(flags, getFlags()...)
-	return append(flags, cli.StringFlag{
-		Name:  "licenses",
-		Value: "",
-		Usage: "[Optional] Default package licenses in the form of Apache-2.0,GPL-3.0...` `",
-	})
+	return append(flags, []cli.Flag{
+		cli.StringFlag{
+			Name:  "licenses",
+			Value: "",
+			Usage: "[Optional] Default package licenses in the form of Apache-2.0,GPL-3.0...` `",
+		},
+		cli.StringFlag{
+			Name:  "limit-rate",
+			Value: "",
+			Usage: "[Optional] Default maximum transfer rate in bytes per second for uploads and downloads. The rate may be followed by K, M or G, for example 512K or 10M.` `",
+		},
+	}...)
 }
 
 func getPackageFlags() []cli.Flag {
@@ -470,6 +478,15 @@ func getDownloadFlags() []cli.Flag {
 			Name:  "unpublished",
 			Usage: "[Default: false] Download both published and unpublished files.",
 		},
+		getLimitRateFlag(),
+	}
+}
+
+func getLimitRateFlag() cli.Flag {
+	return cli.StringFlag{
+		Name:  "limit-rate",
+		Value: "",
+		Usage: "[Optional] Maximum transfer rate in bytes per second, shared by all threads. The rate may be followed by K, M or G, for example 512K or 10M. If not set, the configured rate is used.` `",
 	}
 }
 
@@ -526,6 +543,7 @@ func getUploadFlags() []cli.Flag {
 			Value: "",
 			Usage: "[Optional] Used for Debian packages in the form of distribution/component/architecture.` `",
 		},
+		getLimitRateFlag(),
 	}...)
 }
 
@@ -645,6 +663,11 @@ func configure(c *cli.Context) error {
 		if err != nil {
 			return err
 		}
+		if c.String("limit-rate") != "" {
+			if _, err := ratelimit.ParseRate(c.String("limit-rate")); err != nil {
+				return err
+			}
+		}
 
 		cliBtDetails := &config.BintrayDetails{
 			User:              bintrayDetails.GetUser(),
@@ -652,6 +675,7 @@ func configure(c *cli.Context) error {
 			ApiUrl:            bintrayDetails.GetApiUrl(),
 			DownloadServerUrl: bintrayDetails.GetDownloadServerUrl(),
 			DefPackageLicense: bintrayDetails.GetDefPackageLicense(),
+			LimitRate:         c.String("limit-rate"),
 		}
 		commands.Config(cliBtDetails, nil, interactive)
 	}
@@ -843,7 +867,11 @@ func downloadVersion(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	downloaded, failed, err := commands.DownloadVersion(btConfig, params)
+	limitRate, err := getLimitRate(c)
+	if err != nil {
+		return err
+	}
+	downloaded, failed, err := commands.DownloadVersion(btConfig, params, limitRate)
 	err = cliutils.PrintSummaryReport(downloaded, failed, err)
 	if err != nil {
 		return err
@@ -891,7 +919,11 @@ func upload(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	uploaded, failed, err := commands.Upload(uploadConfig, params)
+	limitRate, err := getLimitRate(c)
+	if err != nil {
+		return err
+	}
+	uploaded, failed, err := commands.Upload(uploadConfig, params, limitRate)
 	err = cliutils.PrintSummaryReport(uploaded, failed, err)
 	if err != nil {
 		return err
@@ -933,7 +965,11 @@ func downloadFile(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	downloaded, failed, err := commands.DownloadFile(btConfig, params)
+	limitRate, err := getLimitRate(c)
+	if err != nil {
+		return err
+	}
+	downloaded, failed, err := commands.DownloadFile(btConfig, params, limitRate)
 	return cliutils.PrintSummaryReport(downloaded, failed, err)
 }
 
@@ -1409,6 +1445,19 @@ func createBintrayDetails(c *cli.Context, includeConfig bool) (auth.BintrayDetai
 	return btDetails, nil
 }
 
+// Returns the transfer rate limit in bytes per second, taken from the --limit-rate option,
+// the Bintray configuration or the JFROG_CLI_LIMIT_RATE environment variable, in that order.
+func getLimitRate(c *cli.Context) (int64, error) {
+	if c.String("limit-rate") != "" {
+		return ratelimit.ParseRate(c.String("limit-rate"))
+	}
+	confDetails, err := commands.GetConfig()
+	if err != nil {
+		return 0, err
+	}
+	return ratelimit.GetLimitRate(confDetails.LimitRate)
+}
+
 func getMinSplitFlag(c *cli.Context) (int64, error) {
 	if c.String("min-split") == "" {
 		return 5120, nil
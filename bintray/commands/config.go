@@ -73,6 +73,9 @@ func ShowConfig() error {
 	if details.DefPackageLicense != "" {
 		log.Output("Default package license: " + details.DefPackageLicense)
 	}
+	if details.LimitRate != "" {
+		log.Output("Limit rate: " + details.LimitRate)
+	}
 	return nil
 }
 
@@ -5,7 +5,14 @@ import (
 	"github.com/jfrog/jfrog-client-go/bintray/services"
 )
 
-func DownloadFile(config bintray.Config, params *services.DownloadFileParams) (totalDownloaded, totalFailed int, err error) {
+func DownloadFile(config bintray.Config, params *services.DownloadFileParams, limitRate int64) (totalDownloaded, totalFailed int, err error) {
+	if limitRate > 0 {
+		downloadService, err := newLimitedDownloadService(config, limitRate)
+		if err != nil {
+			return 0, 0, err
+		}
+		return downloadService.DownloadFile(params)
+	}
 	bt, err := bintray.New(config)
 	if err != nil {
 		return
@@ -13,7 +20,14 @@ func DownloadFile(config bintray.Config, params *services.DownloadFileParams) (t
 	return bt.DownloadFile(params)
 }
 
-func DownloadVersion(config bintray.Config, params *services.DownloadVersionParams) (totalDownloaded, totalFailed int, err error) {
+func DownloadVersion(config bintray.Config, params *services.DownloadVersionParams, limitRate int64) (totalDownloaded, totalFailed int, err error) {
+	if limitRate > 0 {
+		downloadService, err := newLimitedDownloadService(config, limitRate)
+		if err != nil {
+			return 0, 0, err
+		}
+		return downloadService.DownloadVersion(params)
+	}
 	bt, err := bintray.New(config)
 	if err != nil {
 		return
@@ -21,3 +35,14 @@ func DownloadVersion(config bintray.Config, params *services.DownloadVersionPara
 	totalDownloaded, totalFailed, err = bt.DownloadVersion(params)
 	return
 }
+
+func newLimitedDownloadService(config bintray.Config, limitRate int64) (*services.DownloadService, error) {
+	client, err := newLimitedHttpClient(limitRate)
+	if err != nil {
+		return nil, err
+	}
+	downloadService := services.NewDownloadService(client)
+	downloadService.BintrayDetails = config.GetBintrayDetails()
+	downloadService.Threads = config.GetThreads()
+	return downloadService, nil
+}
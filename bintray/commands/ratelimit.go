@@ -0,0 +1,17 @@
+package commands
+
+import (
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
+	"github.com/jfrog/jfrog-client-go/httpclient"
+)
+
+// Creates an http client, whose transfers are throttled to limitRate bytes per second.
+// The limit is shared by all the threads using the client.
+func newLimitedHttpClient(limitRate int64) (*httpclient.HttpClient, error) {
+	client, err := httpclient.ClientBuilder().Build()
+	if err != nil {
+		return nil, err
+	}
+	client.Client.Transport = ratelimit.WrapTransport(client.Client.Transport, ratelimit.NewLimiter(limitRate))
+	return client, nil
+}
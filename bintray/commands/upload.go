@@ -8,11 +8,12 @@ import (
 	"github.com/jfrog/jfrog-client-go/bintray/services/packages"
 	"github.com/jfrog/jfrog-client-go/bintray/services/repositories"
 	"github.com/jfrog/jfrog-client-go/bintray/services/versions"
+	"github.com/jfrog/jfrog-client-go/httpclient"
 	"github.com/jfrog/jfrog-client-go/utils/errorutils"
 	"github.com/jfrog/jfrog-client-go/utils/log"
 )
 
-func Upload(config bintray.Config, uploadDetails *services.UploadParams) (uploaded int, failed int, err error) {
+func Upload(config bintray.Config, uploadDetails *services.UploadParams, limitRate int64) (uploaded int, failed int, err error) {
 	var sm *bintray.ServicesManager
 	sm, err = bintray.New(config)
 	if err != nil {
@@ -51,6 +52,18 @@ func Upload(config bintray.Config, uploadDetails *services.UploadParams) (upload
 		}
 	}
 
+	if limitRate > 0 {
+		var client *httpclient.HttpClient
+		client, err = newLimitedHttpClient(limitRate)
+		if err != nil {
+			return
+		}
+		uploadService := services.NewUploadService(client)
+		uploadService.BintrayDetails = config.GetBintrayDetails()
+		uploadService.DryRun = config.IsDryRun()
+		uploadService.Threads = config.GetThreads()
+		return uploadService.Upload(uploadDetails)
+	}
 	return sm.UploadFiles(uploadDetails)
 }
 
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/codegangsta/cli"
+	"github.com/jfrog/jfrog-cli-go/docs/cache/clean"
+	"github.com/jfrog/jfrog-cli-go/docs/cache/stats"
+	"github.com/jfrog/jfrog-cli-go/docs/common"
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-cli-go/utils/downloadcache"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+func GetCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:         "stats",
+			Usage:        stats.Description,
+			HelpName:     common.CreateUsage("cache stats", stats.Description, stats.Usage),
+			ArgsUsage:    common.CreateEnvVars(common.DownloadCacheEnvVars),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return statsCmd(c)
+			},
+		},
+		{
+			Name:         "clean",
+			Usage:        clean.Description,
+			HelpName:     common.CreateUsage("cache clean", clean.Description, clean.Usage),
+			ArgsUsage:    common.CreateEnvVars(),
+			BashComplete: common.CreateBashCompletionFunc(),
+			Action: func(c *cli.Context) error {
+				return cleanCmd(c)
+			},
+		},
+	}
+}
+
+func statsCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	downloadCache, err := downloadcache.GetDownloadCache()
+	if err != nil {
+		return err
+	}
+	cacheStats, err := downloadCache.Stats()
+	if err != nil {
+		return err
+	}
+	log.Output(fmt.Sprintf("Directory: %s\nFiles: %d\nSize: %d bytes\nMax size: %d bytes", cacheStats.Dir, cacheStats.Files, cacheStats.Size, cacheStats.MaxSize))
+	return nil
+}
+
+func cleanCmd(c *cli.Context) error {
+	if c.NArg() > 0 {
+		return cliutils.PrintHelpAndReturnError("Wrong number of arguments.", c)
+	}
+	downloadCache, err := downloadcache.GetDownloadCache()
+	if err != nil {
+		return err
+	}
+	log.Info("Cleaning the download cache at", downloadCache.Dir())
+	return downloadCache.Clean()
+}
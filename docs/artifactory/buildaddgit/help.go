@@ -1,6 +1,9 @@
 package buildaddgit
 
-const Description = "Collect VCS details from git and add them to a build."
+const Description = "Collect VCS details from git and add them to a build. The URL and revision of the repository are the VCS details of the build. " +
+	"The build info holds a single VCS entry, so the submodules are not added as VCS entries of their own. Instead, the URL and revision of each submodule, and the branch, tags, commit message and author, " +
+	"are added as build properties prefixed with 'vcs.', which are not compared as environment variables by build-diff. " +
+	"Like environment variables, properties matching the --env-exclude patterns are not collected, and values which look like secrets are redacted."
 
 var Usage = []string{"jfrog rt bag [command options] <build name> <build number> [Path To .git]"}
 
@@ -11,4 +14,4 @@ const Arguments string = `	build name
 		Build number.
 
 	path to .git
-		Path to a directory containing the .git directory. If not specific, the .git directory is assumed to be in the current directory.`
+		Path to a directory containing the .git directory, or the .git file of a worktree or submodule. If not specified, .git is looked for in the current directory and in its parents.`
@@ -0,0 +1,17 @@
+package buildappend
+
+const Description = "Append the artifacts of a published build to the build info, as a module or as dependencies."
+
+var Usage = []string{"jfrog rt build-append [command options] <build name> <build number> <build name to append> <build number to append>"}
+
+const Arguments string = `	build name
+		Build name.
+
+	build number
+		Build number.
+
+	build name to append
+		Name of the published build to append.
+
+	build number to append
+		Number of the published build to append. Use LATEST for the latest published build number.`
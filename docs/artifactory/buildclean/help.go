@@ -2,7 +2,9 @@ package buildclean
 
 const Description = "This command is used to clean (remove) build info collected locally."
 
-var Usage = []string{"jfrog rt bc <build name> <build number>"}
+var Usage = []string{"jfrog rt bc <build name> <build number>",
+	"jfrog rt bc --all [--older-than=<age>]",
+	"jfrog rt bc --older-than=<age>"}
 
 const Arguments string = `	build name
 		Build name.
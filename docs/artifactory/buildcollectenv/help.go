@@ -1,8 +1,8 @@
 package buildcollectenv
 
-const Description = "Collect environment variables. Environment variables can be excluded using the build-publish command."
+const Description = "Collect environment variables. Environment variables can be excluded using the --env-include and --env-exclude options, before they are saved. Values which look like secrets, such as tokens, private keys and JWTs, are redacted."
 
-var Usage = []string{"jfrog rt bce <build name> <build number>"}
+var Usage = []string{"jfrog rt bce [command options] <build name> <build number>"}
 
 const Arguments string = `	build name
 		Build name.
@@ -0,0 +1,19 @@
+package builddiff
+
+const Description = "Compare the build info of two builds."
+
+var Usage = []string{"jfrog rt build-diff [command options] <build name> <first build number> <second build number>",
+	"jfrog rt build-diff [command options] <build name> <first build number> <second build name> <second build number>"}
+
+const Arguments string = `	build name
+		Name of the first build. Also the name of the second build, if the second build name is not specified.
+
+	first build number
+		Number of the first build, which is compared with the second build. Use LATEST for the latest published build number.
+
+	second build name
+		Name of the second build.
+
+	second build number
+		Number of the second build. Use LATEST for the latest published build number.
+		If the --local option is set, the build info collected locally for this build is used, instead of the published build info.`
@@ -0,0 +1,12 @@
+package buildexportsbom
+
+const Description = "Export the build info of a build as a Software Bill of Materials (SBOM)."
+
+var Usage = []string{"jfrog rt build-export-sbom [command options] <build name> <build number>"}
+
+const Arguments string = `	build name
+		Build name.
+
+	build number
+		Build number. Use LATEST for the latest published build number.
+		If the --local option is set, the build info collected locally for this build is used, instead of the published build info.`
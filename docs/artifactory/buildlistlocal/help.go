@@ -0,0 +1,7 @@
+package buildlistlocal
+
+const Description = "List the builds with build info collected locally, which were not published yet."
+
+var Usage = []string{"jfrog rt build-list-local [command options]"}
+
+const Arguments string = ""
@@ -0,0 +1,14 @@
+package buildmerge
+
+const Description = "Publish a new build info, which includes the modules and issues of several published builds."
+
+var Usage = []string{"jfrog rt build-merge [command options] <build name> <build number> <merged build>..."}
+
+const Arguments string = `	build name
+		Name of the new build.
+
+	build number
+		Number of the new build.
+
+	merged build
+		A published build to merge, in the form of <build name>/<build number>. Use LATEST as the build number for the latest published build number.`
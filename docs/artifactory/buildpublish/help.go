@@ -1,11 +1,13 @@
 package buildpublish
 
-const Description = "Publish build info."
+const Description = "Publish build info. With the --sign option, the build info is signed, and its signature is uploaded to Artifactory, so that it can be verified using the build-verify-signature command."
 
-var Usage = []string{"jfrog rt bp [command options] <build name> <build number>"}
+var Usage = []string{"jfrog rt bp [command options] <build name> <build number>",
+	"jfrog rt bp --from-file=<build info file> [command options]"}
 
 const Arguments string = `	build name
 		Build name.
 
 	build number
-		Build number.`
+		Build number.
+		If the --from-file option is set, the build name and number are read from the file. If they are specified, they must match the file.`
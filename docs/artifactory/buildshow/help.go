@@ -0,0 +1,11 @@
+package buildshow
+
+const Description = "Show the build info collected locally, as it would be published."
+
+var Usage = []string{"jfrog rt build-show [command options] <build name> <build number>"}
+
+const Arguments string = `	build name
+		Build name.
+
+	build number
+		Build number.`
@@ -0,0 +1,11 @@
+package buildverifysignature
+
+const Description = "Verify the signature of a published build info, uploaded by the build-publish command with the --sign option."
+
+var Usage = []string{"jfrog rt build-verify-signature [command options] <build name> <build number>"}
+
+const Arguments string = `	build name
+		Build name.
+
+	build number
+		Build number. Use LATEST for the latest published build number.`
@@ -0,0 +1,5 @@
+package cleanup
+
+const Description = "Delete files according to a retention policy."
+
+var Usage = []string{"jfrog rt cleanup --policy=<retention policy path> [command options]"}
@@ -0,0 +1,12 @@
+package diff
+
+const Description = "Compare a local directory with a path in Artifactory."
+
+var Usage = []string{"jfrog rt diff [command options] <local path> <repository path>"}
+
+const Arguments string = `	local path
+		Path to a local directory.
+
+	repository path
+		Path in Artifactory, in the following format: <repository name>/<repository path>.
+		The files under this path are compared with the files under the local path, using their SHA1 checksums.`
@@ -0,0 +1,9 @@
+package ls
+
+const Description = "List the files and folders under a path in Artifactory."
+
+var Usage = []string{"jfrog rt ls [command options] <repository path>"}
+
+const Arguments string = `	repository path
+		Path in Artifactory, in the following format: <repository name>/<repository path>.
+		The files and folders directly under this path are listed. If the path is a file, the file itself is listed.`
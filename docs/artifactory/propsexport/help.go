@@ -0,0 +1,14 @@
+package propsexport
+
+const Description = "Export the properties of files in Artifactory to a mapping file."
+
+var Usage = []string{"jfrog rt pe [command options] <artifacts pattern> <mapping file path>",
+	"jfrog rt pe --spec=<File Spec path> [command options] <mapping file path>"}
+
+const Arguments string = `	artifacts pattern
+		The properties of the artifacts that match the pattern are exported.
+
+	mapping file path
+		Path to the .csv or .json file to which the properties are exported.
+		The file can be applied on Artifactory using the set-props command with the --from-file option.
+		If the search of any of the spec file groups fails, the command fails without writing the file.`
@@ -0,0 +1,5 @@
+package quarantinepurge
+
+const Description = "Permanently delete files from the quarantine repository."
+
+var Usage = []string{"jfrog rt quarantine-purge --older-than=<days> [command options]"}
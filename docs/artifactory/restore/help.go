@@ -0,0 +1,11 @@
+package restore
+
+const Description = "Restore files deleted into the quarantine repository."
+
+var Usage = []string{"jfrog rt restore --deletion-id=<deletion ID> [command options]",
+	"jfrog rt restore [command options] <original path pattern>"}
+
+const Arguments string = `	original path pattern
+		Specifies the original path of the deleted artifacts which should be restored,
+		in the following format: <repository name>/<repository path>. You can use wildcards to specify multiple artifacts.
+		Not needed when the --deletion-id option is used.`
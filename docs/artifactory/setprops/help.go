@@ -3,10 +3,18 @@ package setprops
 const Description = "Set properties on existing files in Artifactory."
 
 var Usage = []string{"jfrog rt sp [command options] <artifacts pattern> <artifact properties>",
-	"jfrog rt sp <artifact properties> --spec=<File Spec path> [command options]"}
+	"jfrog rt sp <artifact properties> --spec=<File Spec path> [command options]",
+	"jfrog rt sp --from-file=<mapping file path> [command options]"}
 
 const Arguments string = `	artifacts pattern
 		Artifacts that match the pattern will be set with the specified properties.
 
 	artifact properties
-		The list of properties, in the form of key1=value1;key2=value2,..., to be set on the matching artifacts.`
+		The list of properties, in the form of key1=value1;key2=value2,..., to be set on the matching artifacts.
+
+	mapping file path
+		A .csv or .json file, in which each row maps an artifacts pattern to its own list of properties.
+		A CSV file holds 'path,props' rows. A JSON file holds an array of {"path": "...", "props": "..."} objects.
+		A comma, semicolon, equals sign or backslash which is part of a key or a value is escaped with a backslash, for example key=a\,b.
+		The rows are applied in parallel, and a result is reported for each row. A row which fails or matches no artifacts fails the command.
+		Such a file can be created using the props-export command.`
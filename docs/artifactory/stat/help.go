@@ -0,0 +1,10 @@
+package stat
+
+const Description = "Show the details of a file or folder in Artifactory."
+
+var Usage = []string{"jfrog rt stat [command options] <repository path>"}
+
+const Arguments string = `	repository path
+		Path of a file or folder in Artifactory, in the following format: <repository name>/<repository path>.
+		The checksums, properties, creator and modification details are shown. For a file, its download statistics
+		and the builds which include it as an artifact are shown too.`
@@ -0,0 +1,19 @@
+package transfer
+
+const Description = "Transfer files from one Artifactory server to another."
+
+var Usage = []string{"jfrog rt transfer --source-server-id=<server ID> --target-server-id=<server ID> [command options] <source pattern> <target pattern>",
+	"jfrog rt transfer --source-server-id=<server ID> --target-server-id=<server ID> --spec=<File Spec path> [command options]"}
+
+const Arguments string = `	source Pattern
+		Specifies the source path in the source Artifactory server, from which the artifacts should be transferred,
+		in the following format: <repository name>/<repository path>. You can use wildcards to specify multiple artifacts.
+
+	target Pattern
+		Specifies the target path in the target Artifactory server, to which the artifacts should be transferred, in the following format: <repository name>/<repository path>.
+		If the pattern ends with a slash, the target path is assumed to be a folder. For example, if you specify the target as "repo-name/a/b/",
+		then "b" is assumed to be a folder in Artifactory into which files should be transferred.
+		If there is no terminal slash, the target path is assumed to be a file to which the transferred file should be renamed.
+		For flexibility in specifying the target path, you can include placeholders in the form of {1}, {2} which are replaced by corresponding
+		tokens in the source path that are enclosed in parenthesis.
+		Artifacts which already exist in the target path with the same checksum are skipped, so an interrupted transfer can be resumed by running the command again.`
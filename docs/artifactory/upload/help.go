@@ -22,3 +22,8 @@ const Arguments string = `	source pattern
 const EnvVar string = `	JFROG_CLI_MIN_CHECKSUM_DEPLOY_SIZE_KB
 		[Default: 10]
 		Minimum file size in KB for which JFrog CLI performs checksum deploy optimization.`
+
+const SigningEnvVar string = `	JFROG_CLI_SIGNING_PASSPHRASE
+		[Optional]
+		Passphrase of the GPG key used for signing the uploaded files, if the key is encrypted.
+		Used when the --sign-keyring command option is sent.`
@@ -0,0 +1,18 @@
+package verify
+
+const Description = "Verify local files against the checksums in Artifactory."
+
+var Usage = []string{"jfrog rt verify [command options] <local path> <repository path>",
+	"jfrog rt verify --spec=<File Spec path> [command options]",
+	"jfrog rt verify --build=<build name>/<build number> [command options] <local path>"}
+
+const Arguments string = `	local path
+		Path to a local directory.
+
+	repository path
+		Path in Artifactory, in the following format: <repository name>/<repository path>.
+		The files under this path are expected to exist under the local path, with the same SHA1 and SHA256 checksums.
+
+	When the --spec option is used, the local files are the files downloaded by the download File Spec.
+	When the --build option is used without --spec, the artifacts and dependencies of the published build are expected to exist under the local path.
+	Missing, extra and corrupted files are reported.`
@@ -0,0 +1,5 @@
+package clean
+
+const Description = "Remove all the files from the local download cache."
+
+var Usage = []string{"jfrog cache clean"}
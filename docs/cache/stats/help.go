@@ -0,0 +1,5 @@
+package stats
+
+const Description = "Show the local download cache statistics."
+
+var Usage = []string{"jfrog cache stats"}
@@ -1,5 +1,26 @@
 package common
 
+const LimitRateEnvVar string = `	JFROG_CLI_LIMIT_RATE
+		[Optional]
+		Maximum transfer rate in bytes per second, shared by all the transfer threads of the command.
+		The rate may be followed by K, M or G, for example 512K or 10M.
+		Used when the --limit-rate command option is not sent and no rate is configured for the server.`
+
+const QuarantineRepoEnvVar string = `	JFROG_CLI_QUARANTINE_REPO
+		[Optional]
+		Artifactory repository into which deleted files are moved, instead of being permanently deleted.
+		Used when the --quarantine-repo command option is not sent.`
+
+const DownloadCacheEnvVars string = `	JFROG_CLI_DOWNLOAD_CACHE
+		[Default: false]
+		Set to true to use the local download cache. For the download command, this is the same as sending the --use-cache command option.
+		The go, pip-install, mvn and gradle commands use the cache only when this environment variable is set.
+
+	JFROG_CLI_DOWNLOAD_CACHE_MAX_SIZE
+		[Default: 10G]
+		Maximum size of the local download cache. When exceeded, the least recently used files are removed from the cache.
+		The size may be followed by K, M or G, for example 500M.`
+
 const GlobalEnvVars string = `	JFROG_CLI_LOG_LEVEL
 		[Default: INFO]
 		This variable determines the log level of the JFrog CLI.
@@ -21,6 +42,11 @@ const GlobalEnvVars string = `	JFROG_CLI_LOG_LEVEL
 		[Default: The operating system's temp directory]
 		Defines the temp directory used by JFrog CLI.
 
+	JFROG_CLI_TEMP_DATA_EXPIRY
+		[Default: 7d]
+		Age after which the data left in the temp directory by builds which were never published or cleaned is removed automatically,
+		for example 7d or 12h. Set to 0 to never remove it automatically.
+
 	JFROG_CLI_BUILD_NAME
 		Build name to be used by commands which expect a build name, unless sent as a command argument or option.
 	
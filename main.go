@@ -5,6 +5,7 @@ import (
 	"github.com/codegangsta/cli"
 	"github.com/jfrog/jfrog-cli-go/artifactory"
 	"github.com/jfrog/jfrog-cli-go/bintray"
+	"github.com/jfrog/jfrog-cli-go/cache"
 	"github.com/jfrog/jfrog-cli-go/completion"
 	"github.com/jfrog/jfrog-cli-go/docs/common"
 	"github.com/jfrog/jfrog-cli-go/missioncontrol"
@@ -113,6 +114,11 @@ func getCommands() []cli.Command {
 			Usage:       "Xray commands",
 			Subcommands: xray.GetCommands(),
 		},
+		{
+			Name:        cliutils.CmdCache,
+			Usage:       "Local download cache commands",
+			Subcommands: cache.GetCommands(),
+		},
 		{
 			Name:        cliutils.CmdCompletion,
 			Usage:       "Generate autocomplete scripts",
@@ -12,12 +12,16 @@ const (
 	CmdMissionControl = "mc"
 	CmdXray           = "xr"
 	CmdCompletion     = "completion"
+	CmdCache          = "cache"
 
 	// Download
 	DownloadMinSplitKb    = 5120
 	DownloadSplitCount    = 3
 	DownloadMaxSplitCount = 15
 
+	// Upload
+	WatchIntervalSeconds = 5
+
 	// Common
 	Retries = 3
 
@@ -28,12 +32,18 @@ const (
 	JfrogHomeDirEnv         = "JFROG_CLI_HOME_DIR"
 	JFrogCliErrorHandling   = "JFROG_CLI_ERROR_HANDLING"
 	JFrogCliTempDir         = "JFROG_CLI_TEMP_DIR"
+	TempDataExpiry          = "JFROG_CLI_TEMP_DATA_EXPIRY"
 	CI                      = "CI"
 	JFrogCliDependenciesDir = "JFROG_CLI_DEPENDENCIES_DIR"
 	BuildName               = "JFROG_CLI_BUILD_NAME"
 	BuildNumber             = "JFROG_CLI_BUILD_NUMBER"
 	BuildUrl                = "JFROG_CLI_BUILD_URL"
 	EnvExclude              = "JFROG_CLI_ENV_EXCLUDE"
+	LimitRate               = "JFROG_CLI_LIMIT_RATE"
+	QuarantineRepo          = "JFROG_CLI_QUARANTINE_REPO"
+	DownloadCache           = "JFROG_CLI_DOWNLOAD_CACHE"
+	DownloadCacheMaxSize    = "JFROG_CLI_DOWNLOAD_CACHE_MAX_SIZE"
+	SigningPassphrase       = "JFROG_CLI_SIGNING_PASSPHRASE"
 	// Deprecated:
 	JfrogHomeEnv = "JFROG_CLI_HOME"
 )
@@ -349,6 +349,7 @@ type ArtifactoryDetails struct {
 	ServerId       string            `json:"serverId,omitempty"`
 	IsDefault      bool              `json:"isDefault,omitempty"`
 	InsecureTls    bool              `json:"-"`
+	LimitRate      string            `json:"limitRate,omitempty"`
 	// Deprecated, use password option instead.
 	ApiKey string `json:"apiKey,omitempty"`
 }
@@ -359,6 +360,7 @@ type BintrayDetails struct {
 	User              string `json:"user,omitempty"`
 	Key               string `json:"key,omitempty"`
 	DefPackageLicense string `json:"defPackageLicense,omitempty"`
+	LimitRate         string `json:"limitRate,omitempty"`
 }
 
 type MissionControlDetails struct {
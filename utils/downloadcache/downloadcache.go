@@ -0,0 +1,296 @@
+package downloadcache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-cli-go/utils/config"
+	"github.com/jfrog/jfrog-cli-go/utils/ratelimit"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// The default maximum size of the cache, in bytes.
+const DefaultMaxSize = 10 * 1024 * 1024 * 1024
+
+// The directory of the cache which maps keys, such as download URLs, to the SHA1 checksums of their files.
+const keysDir = "keys"
+
+// A content-addressable cache of downloaded files, shared by all the JFrog CLI executions which use the same JFrog home.
+// Each file is stored once, named by its SHA1 checksum. When the cache exceeds its maximum size,
+// the least recently used files are removed.
+type DownloadCache struct {
+	dir     string
+	maxSize int64
+}
+
+type Stats struct {
+	Dir     string `json:"dir"`
+	Files   int    `json:"files"`
+	Size    int64  `json:"size"`
+	MaxSize int64  `json:"maxSize"`
+}
+
+func New(dir string, maxSize int64) *DownloadCache {
+	return &DownloadCache{dir: dir, maxSize: maxSize}
+}
+
+// Returns the download cache under the JFrog home directory.
+// The maximum size is taken from the JFROG_CLI_DOWNLOAD_CACHE_MAX_SIZE environment variable, if set.
+func GetDownloadCache() (*DownloadCache, error) {
+	dir, err := config.CreateDirInJfrogHome(filepath.Join("cache", "downloads"))
+	if err != nil {
+		return nil, err
+	}
+	maxSize := int64(DefaultMaxSize)
+	if value := os.Getenv(cliutils.DownloadCacheMaxSize); value != "" {
+		// The size has the same format as a transfer rate, for example 500M or 10G.
+		maxSize, err = ratelimit.ParseRate(value)
+		if err != nil {
+			return nil, errorutils.CheckError(errors.New("Invalid " + cliutils.DownloadCacheMaxSize + " value: " + value))
+		}
+	}
+	return New(dir, maxSize), nil
+}
+
+// Returns true if the download cache should be used, either because it was requested for the command
+// or by the JFROG_CLI_DOWNLOAD_CACHE environment variable.
+func IsEnabled(requested bool) bool {
+	return requested || strings.ToLower(os.Getenv(cliutils.DownloadCache)) == "true"
+}
+
+func (dc *DownloadCache) Dir() string {
+	return dc.dir
+}
+
+func (dc *DownloadCache) MaxSize() int64 {
+	return dc.maxSize
+}
+
+func (dc *DownloadCache) entryPath(sha1 string) string {
+	return filepath.Join(dc.dir, sha1[:2], sha1)
+}
+
+// Places the cached file with the specified SHA1 checksum in the target path, by hard-linking it, or by copying it
+// if linking is not possible. Returns false if the file is not in the cache.
+// A cached file whose content no longer matches its checksum is removed from the cache.
+func (dc *DownloadCache) Get(sha1, targetPath string) (bool, error) {
+	if len(sha1) < 2 {
+		return false, nil
+	}
+	entry := dc.entryPath(sha1)
+	if _, err := os.Stat(entry); err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errorutils.CheckError(err)
+	}
+	actualSha1, err := calcSha1(entry)
+	if err != nil {
+		return false, err
+	}
+	if actualSha1 != sha1 {
+		log.Warn("Removing corrupted download cache entry", entry)
+		return false, errorutils.CheckError(os.Remove(entry))
+	}
+	if err = os.MkdirAll(filepath.Dir(targetPath), 0777); err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	if err = linkOrCopy(entry, targetPath); err != nil {
+		return false, err
+	}
+	// The modification time of an entry is its last use time, used for evicting the least recently used entries.
+	now := time.Now()
+	return true, errorutils.CheckError(os.Chtimes(entry, now, now))
+}
+
+// Adds the file in the source path to the cache, under the specified SHA1 checksum.
+// The least recently used files are then removed, if the cache exceeds its maximum size.
+func (dc *DownloadCache) Put(sha1, sourcePath string) error {
+	if len(sha1) < 2 {
+		return nil
+	}
+	entry := dc.entryPath(sha1)
+	if _, err := os.Stat(entry); err == nil {
+		now := time.Now()
+		return errorutils.CheckError(os.Chtimes(entry, now, now))
+	}
+	if err := os.MkdirAll(filepath.Dir(entry), 0777); err != nil {
+		return errorutils.CheckError(err)
+	}
+	// The file is added under a temporary name and then renamed, so that other executions never see a partial file.
+	tempFile, err := ioutil.TempFile(filepath.Dir(entry), sha1+".tmp.")
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	tempPath := tempFile.Name()
+	tempFile.Close()
+	defer os.Remove(tempPath)
+	if err = linkOrCopy(sourcePath, tempPath); err != nil {
+		return err
+	}
+	if err = os.Rename(tempPath, entry); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return dc.evict()
+}
+
+// Places the cached file with the specified key in the target path. Returns false if the file is not in the cache.
+// This allows using the cache when the checksum of the file is not known before it is downloaded. Only files whose
+// content never changes, such as released versions, should be looked up by a key, such as their download URL.
+func (dc *DownloadCache) GetByKey(key, targetPath string) (bool, error) {
+	sha1, err := ioutil.ReadFile(dc.keyPath(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errorutils.CheckError(err)
+	}
+	return dc.Get(string(sha1), targetPath)
+}
+
+// Adds the file in the source path to the cache, under both its SHA1 checksum and the specified key.
+func (dc *DownloadCache) PutByKey(key, sourcePath string) error {
+	sha1, err := calcSha1(sourcePath)
+	if err != nil {
+		return err
+	}
+	if err = dc.Put(sha1, sourcePath); err != nil {
+		return err
+	}
+	keyPath := dc.keyPath(key)
+	if err = os.MkdirAll(filepath.Dir(keyPath), 0777); err != nil {
+		return errorutils.CheckError(err)
+	}
+	return errorutils.CheckError(ioutil.WriteFile(keyPath, []byte(sha1), 0644))
+}
+
+// Each key is stored in a file named by the SHA1 checksum of the key, which contains the SHA1 checksum of its file.
+// A key whose file was evicted is a cache miss, until the file is added again.
+func (dc *DownloadCache) keyPath(key string) string {
+	hash := sha1.Sum([]byte(key))
+	return filepath.Join(dc.dir, keysDir, hex.EncodeToString(hash[:]))
+}
+
+// Removes the least recently used files, until the cache size does not exceed its maximum size.
+func (dc *DownloadCache) evict() error {
+	entries, err := dc.listEntries()
+	if err != nil {
+		return err
+	}
+	var size int64
+	for _, entry := range entries {
+		size += entry.info.Size()
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].info.ModTime().Before(entries[j].info.ModTime())
+	})
+	for _, entry := range entries {
+		if size <= dc.maxSize {
+			break
+		}
+		log.Debug("Evicting download cache entry", entry.path)
+		if err = os.Remove(entry.path); err != nil && !os.IsNotExist(err) {
+			return errorutils.CheckError(err)
+		}
+		size -= entry.info.Size()
+	}
+	return nil
+}
+
+func (dc *DownloadCache) Stats() (*Stats, error) {
+	entries, err := dc.listEntries()
+	if err != nil {
+		return nil, err
+	}
+	stats := &Stats{Dir: dc.dir, Files: len(entries), MaxSize: dc.maxSize}
+	for _, entry := range entries {
+		stats.Size += entry.info.Size()
+	}
+	return stats, nil
+}
+
+// Removes all the files from the cache.
+func (dc *DownloadCache) Clean() error {
+	entries, err := ioutil.ReadDir(dc.dir)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	for _, entry := range entries {
+		if err = os.RemoveAll(filepath.Join(dc.dir, entry.Name())); err != nil {
+			return errorutils.CheckError(err)
+		}
+	}
+	return nil
+}
+
+type cacheEntry struct {
+	path string
+	info os.FileInfo
+}
+
+func (dc *DownloadCache) listEntries() ([]cacheEntry, error) {
+	var entries []cacheEntry
+	err := filepath.Walk(dc.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Entries may be removed by other executions while walking.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() && path == filepath.Join(dc.dir, keysDir) {
+			return filepath.SkipDir
+		}
+		if info.Mode().IsRegular() && !strings.Contains(info.Name(), ".tmp.") {
+			entries = append(entries, cacheEntry{path: path, info: info})
+		}
+		return nil
+	})
+	return entries, errorutils.CheckError(err)
+}
+
+func linkOrCopy(sourcePath, targetPath string) error {
+	if err := os.Remove(targetPath); err != nil && !os.IsNotExist(err) {
+		return errorutils.CheckError(err)
+	}
+	if os.Link(sourcePath, targetPath) == nil {
+		return nil
+	}
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	defer source.Close()
+	target, err := os.Create(targetPath)
+	if err != nil {
+		return errorutils.CheckError(err)
+	}
+	_, err = io.Copy(target, source)
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	return errorutils.CheckError(err)
+}
+
+func calcSha1(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	defer file.Close()
+	hash := sha1.New()
+	if _, err = io.Copy(hash, file); err != nil {
+		return "", errorutils.CheckError(err)
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,146 @@
+package downloadcache
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/utils/log"
+)
+
+func TestMain(m *testing.M) {
+	log.SetDefaultLogger()
+	os.Exit(m.Run())
+}
+
+func createFile(t *testing.T, dir, name, content string) (filePath, sha1 string) {
+	filePath = filepath.Join(dir, name)
+	if err := ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	sha1, err := calcSha1(filePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return filePath, sha1
+}
+
+func TestPutAndGet(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "download-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	cache := New(filepath.Join(tempDir, "cache"), DefaultMaxSize)
+
+	sourcePath, sha1 := createFile(t, tempDir, "a.txt", "content")
+	found, err := cache.Get(sha1, filepath.Join(tempDir, "out", "a.txt"))
+	if err != nil || found {
+		t.Fatalf("Expected a cache miss, got %t (%v).", found, err)
+	}
+	if err = cache.Put(sha1, sourcePath); err != nil {
+		t.Fatal(err)
+	}
+	targetPath := filepath.Join(tempDir, "out", "b.txt")
+	found, err = cache.Get(sha1, targetPath)
+	if err != nil || !found {
+		t.Fatalf("Expected a cache hit, got %t (%v).", found, err)
+	}
+	content, err := ioutil.ReadFile(targetPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "content" {
+		t.Errorf("Unexpected content: %s", content)
+	}
+
+	// A corrupted entry is removed instead of being used.
+	if err = ioutil.WriteFile(cache.entryPath(sha1), []byte("corrupted"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	found, err = cache.Get(sha1, filepath.Join(tempDir, "out", "c.txt"))
+	if err != nil || found {
+		t.Fatalf("Expected a corrupted entry to be a cache miss, got %t (%v).", found, err)
+	}
+	stats, err := cache.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Files != 0 {
+		t.Errorf("Expected the corrupted entry to be removed, found %d files.", stats.Files)
+	}
+}
+
+func TestEvictLeastRecentlyUsed(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "download-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	// Each file is 4 bytes, so only 2 files fit in the cache.
+	cache := New(filepath.Join(tempDir, "cache"), 8)
+
+	var sha1s []string
+	for i, content := range []string{"aaaa", "bbbb", "cccc"} {
+		sourcePath, sha1 := createFile(t, tempDir, content, content)
+		if err = cache.Put(sha1, sourcePath); err != nil {
+			t.Fatal(err)
+		}
+		// Make the use times distinct, with the first file being the least recently used.
+		useTime := time.Now().Add(time.Duration(i-10) * time.Minute)
+		if err = os.Chtimes(cache.entryPath(sha1), useTime, useTime); err != nil {
+			t.Fatal(err)
+		}
+		sha1s = append(sha1s, sha1)
+	}
+	stats, err := cache.Stats()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Files != 2 || stats.Size != 8 {
+		t.Fatalf("Expected 2 files of 8 bytes, got %d files of %d bytes.", stats.Files, stats.Size)
+	}
+	if _, err = os.Stat(cache.entryPath(sha1s[0])); !os.IsNotExist(err) {
+		t.Error("Expected the least recently used file to be evicted.")
+	}
+
+	if err = cache.Clean(); err != nil {
+		t.Fatal(err)
+	}
+	if stats, err = cache.Stats(); err != nil || stats.Files != 0 {
+		t.Errorf("Expected an empty cache after clean, got %v (%v).", stats, err)
+	}
+}
+
+func TestPutAndGetByKey(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "download-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	cache := New(filepath.Join(tempDir, "cache"), DefaultMaxSize)
+
+	key := "org/jfrog/extractor/1.0/extractor-1.0.jar"
+	found, err := cache.GetByKey(key, filepath.Join(tempDir, "out", "a.jar"))
+	if err != nil || found {
+		t.Fatalf("Expected a cache miss, got %t (%v).", found, err)
+	}
+	sourcePath, _ := createFile(t, tempDir, "extractor.jar", "jar")
+	if err = cache.PutByKey(key, sourcePath); err != nil {
+		t.Fatal(err)
+	}
+	targetPath := filepath.Join(tempDir, "out", "a.jar")
+	found, err = cache.GetByKey(key, targetPath)
+	if err != nil || !found {
+		t.Fatalf("Expected a cache hit, got %t (%v).", found, err)
+	}
+	if content, err := ioutil.ReadFile(targetPath); err != nil || string(content) != "jar" {
+		t.Errorf("Unexpected content: %s (%v)", content, err)
+	}
+	// The keys are not counted as cached files.
+	if stats, err := cache.Stats(); err != nil || stats.Files != 1 {
+		t.Errorf("Expected a single cached file, got %v (%v).", stats, err)
+	}
+}
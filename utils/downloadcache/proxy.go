@@ -0,0 +1,199 @@
+package downloadcache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	"github.com/jfrog/jfrog-client-go/utils/log"
+)
+
+// Artifactory returns the SHA1 checksum of the files it stores in this header.
+const checksumSha1Header = "X-Checksum-Sha1"
+
+// The headers of a file, which are returned when the file is served from the cache.
+var cachedFileHeaders = []string{"Content-Type", "Content-Disposition", "Last-Modified", "ETag", "X-Checksum-Sha1", "X-Checksum-Sha256", "X-Checksum-Md5"}
+
+// A local HTTP proxy to an Artifactory server, which serves the files it downloads through the download cache.
+// Package managers which download their packages by themselves, such as go and pip, can be pointed to the proxy
+// instead of to the server. The checksum of each file is taken from the X-Checksum-Sha1 header. Requests for
+// files which are not stored in Artifactory, such as package indexes, and requests other than GET are passed as is.
+type Proxy struct {
+	cache        *DownloadCache
+	upstream     *url.URL
+	client       *http.Client
+	reverseProxy *httputil.ReverseProxy
+	listener     net.Listener
+}
+
+// Starts a proxy to the upstream URL, which sends the requests to the server using the client.
+func StartProxy(cache *DownloadCache, upstreamUrl string, client *http.Client) (*Proxy, error) {
+	upstream, err := url.Parse(upstreamUrl)
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, errorutils.CheckError(err)
+	}
+	proxy := &Proxy{cache: cache, upstream: upstream, client: client, listener: listener}
+	proxy.reverseProxy = httputil.NewSingleHostReverseProxy(upstream)
+	director := proxy.reverseProxy.Director
+	proxy.reverseProxy.Director = func(req *http.Request) {
+		director(req)
+		req.Host = upstream.Host
+		// Let the transport handle the compression, so that the checksum of the body can be compared with the checksum header.
+		req.Header.Del("Accept-Encoding")
+	}
+	proxy.reverseProxy.Transport = client.Transport
+	proxy.reverseProxy.ModifyResponse = proxy.cacheResponse
+	go http.Serve(listener, proxy)
+	log.Debug("Started the download cache proxy to", upstream.Host, "on", listener.Addr().String())
+	return proxy, nil
+}
+
+// Returns the URL of the proxy, which replaces the URL of the server.
+func (p *Proxy) Url() string {
+	return "http://" + p.listener.Addr().String() + "/"
+}
+
+func (p *Proxy) Close() error {
+	return errorutils.CheckError(p.listener.Close())
+}
+
+func (p *Proxy) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method == http.MethodGet && req.Header.Get("Range") == "" {
+		served, err := p.serveFromCache(w, req)
+		if err != nil {
+			log.Debug("Failed serving", req.URL.Path, "from the download cache:", err.Error())
+		}
+		if served {
+			return
+		}
+	}
+	p.reverseProxy.ServeHTTP(w, req)
+}
+
+// Serves the requested file from the cache, if the server has a file with the same checksum.
+// The checksum is fetched with a HEAD request, so the content of the file is not downloaded.
+func (p *Proxy) serveFromCache(w http.ResponseWriter, req *http.Request) (bool, error) {
+	headUrl := *p.upstream
+	headUrl.Path = singleJoiningSlash(p.upstream.Path, req.URL.Path)
+	headUrl.RawQuery = req.URL.RawQuery
+	headReq, err := http.NewRequest(http.MethodHead, headUrl.String(), nil)
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	headReq.Header = req.Header.Clone()
+	resp, err := p.client.Do(headReq)
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	resp.Body.Close()
+	sha1 := resp.Header.Get(checksumSha1Header)
+	if resp.StatusCode != http.StatusOK || sha1 == "" {
+		return false, nil
+	}
+	tempDir, err := ioutil.TempDir("", "jfrog.cli.download.cache.")
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	defer os.RemoveAll(tempDir)
+	cachedPath := filepath.Join(tempDir, "file")
+	found, err := p.cache.Get(sha1, cachedPath)
+	if err != nil || !found {
+		return false, err
+	}
+	file, err := os.Open(cachedPath)
+	if err != nil {
+		return false, errorutils.CheckError(err)
+	}
+	defer file.Close()
+	for _, header := range cachedFileHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			w.Header().Set(header, value)
+		}
+	}
+	log.Debug("Serving", req.URL.Path, "from the download cache.")
+	// The status was already sent, so a failure to send the content can only be logged.
+	w.WriteHeader(http.StatusOK)
+	if _, err = io.Copy(w, file); err != nil {
+		log.Debug("Failed sending", req.URL.Path, "from the download cache:", err.Error())
+	}
+	return true, nil
+}
+
+// Adds the downloaded file to the cache once the client has read all of it, if its content matches the checksum header.
+func (p *Proxy) cacheResponse(resp *http.Response) error {
+	sha1Header := resp.Header.Get(checksumSha1Header)
+	if resp.Request.Method != http.MethodGet || resp.StatusCode != http.StatusOK || sha1Header == "" {
+		return nil
+	}
+	tempFile, err := ioutil.TempFile("", "jfrog.cli.download.cache.")
+	if err != nil {
+		// The cache is used on a best-effort basis, so the file is passed to the client without being cached.
+		log.Debug("Failed creating a temporary file for the download cache:", err.Error())
+		return nil
+	}
+	resp.Body = &cachingReader{body: resp.Body, file: tempFile, hash: sha1.New(), sha1: sha1Header, cache: p.cache}
+	return nil
+}
+
+// Writes the body of a response to a file while it is read, and adds the file to the cache when the body is closed.
+type cachingReader struct {
+	body     io.ReadCloser
+	file     *os.File
+	hash     hash.Hash
+	sha1     string
+	cache    *DownloadCache
+	complete bool
+	failed   bool
+}
+
+func (cr *cachingReader) Read(p []byte) (int, error) {
+	n, err := cr.body.Read(p)
+	if n > 0 && !cr.failed {
+		cr.hash.Write(p[:n])
+		if _, writeErr := cr.file.Write(p[:n]); writeErr != nil {
+			cr.failed = true
+		}
+	}
+	if err == io.EOF {
+		cr.complete = true
+	}
+	return n, err
+}
+
+func (cr *cachingReader) Close() error {
+	err := cr.body.Close()
+	cr.file.Close()
+	defer os.Remove(cr.file.Name())
+	if cr.complete && !cr.failed && hex.EncodeToString(cr.hash.Sum(nil)) == cr.sha1 {
+		if putErr := cr.cache.Put(cr.sha1, cr.file.Name()); putErr != nil {
+			log.Debug("Failed adding a file to the download cache:", putErr.Error())
+		}
+	}
+	return err
+}
+
+// Joins the path of the server URL and the path of the request, as done by httputil.NewSingleHostReverseProxy.
+func singleJoiningSlash(a, b string) string {
+	aSlash := len(a) > 0 && a[len(a)-1] == '/'
+	bSlash := len(b) > 0 && b[0] == '/'
+	switch {
+	case aSlash && bSlash:
+		return a + b[1:]
+	case !aSlash && !bSlash:
+		return a + "/" + b
+	}
+	return a + b
+}
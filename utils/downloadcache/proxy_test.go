@@ -0,0 +1,78 @@
+package downloadcache
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestProxy(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "download-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	cache := New(filepath.Join(tempDir, "cache"), DefaultMaxSize)
+
+	content := "package content"
+	hash := sha1.Sum([]byte(content))
+	downloads := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/artifactory/api/pypi/pypi/packages/package.whl":
+			w.Header().Set(checksumSha1Header, hex.EncodeToString(hash[:]))
+			if r.Method == http.MethodGet {
+				downloads[r.URL.Path]++
+				fmt.Fprint(w, content)
+			}
+		case "/artifactory/api/pypi/pypi/simple/package/":
+			if r.Method == http.MethodGet {
+				downloads[r.URL.Path]++
+				fmt.Fprint(w, "index")
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	proxy, err := StartProxy(cache, server.URL+"/artifactory/", server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proxy.Close()
+	get := func(path string) string {
+		resp, err := http.Get(proxy.Url() + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(body)
+	}
+
+	for i := 0; i < 2; i++ {
+		if body := get("api/pypi/pypi/packages/package.whl"); body != content {
+			t.Errorf("Expected the package content, got %s.", body)
+		}
+		if body := get("api/pypi/pypi/simple/package/"); body != "index" {
+			t.Errorf("Expected the package index, got %s.", body)
+		}
+	}
+	// The package is downloaded once and then served from the cache, while the index has no checksum and is not cached.
+	if downloads["/artifactory/api/pypi/pypi/packages/package.whl"] != 1 {
+		t.Errorf("Expected the package to be downloaded once, but it was downloaded %d times.", downloads["/artifactory/api/pypi/pypi/packages/package.whl"])
+	}
+	if downloads["/artifactory/api/pypi/pypi/simple/package/"] != 2 {
+		t.Errorf("Expected the index to be downloaded twice, but it was downloaded %d times.", downloads["/artifactory/api/pypi/pypi/simple/package/"])
+	}
+}
@@ -0,0 +1,207 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jfrog/jfrog-cli-go/utils/cliutils"
+	"github.com/jfrog/jfrog-client-go/utils/errorutils"
+	ioUtils "github.com/jfrog/jfrog-client-go/utils/io"
+)
+
+// The minimal bucket size. Prevents very low rates from splitting every read into tiny chunks.
+const minBurst = 32 * 1024
+
+// A token bucket, shared by all the readers it wraps.
+// Every byte read through the bucket consumes a token. Tokens are refilled at a constant rate of bytes per second.
+type Limiter struct {
+	mutex  sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+// Creates a new limiter, allowing up to 'bytesPerSecond' bytes to be read per second.
+func NewLimiter(bytesPerSecond int64) *Limiter {
+	burst := int(bytesPerSecond)
+	if burst < minBurst {
+		burst = minBurst
+	}
+	return &Limiter{rate: float64(bytesPerSecond), burst: burst, tokens: float64(burst), last: time.Now()}
+}
+
+// Blocks until n bytes may be transferred.
+func (l *Limiter) WaitN(n int) {
+	for n > 0 {
+		chunk := n
+		if chunk > l.burst {
+			chunk = l.burst
+		}
+		time.Sleep(l.reserve(chunk))
+		n -= chunk
+	}
+}
+
+// Takes n tokens from the bucket and returns how long the caller should wait before using them.
+// The bucket may go into debt, so that concurrent callers queue up behind each other.
+func (l *Limiter) reserve(n int) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > float64(l.burst) {
+		l.tokens = float64(l.burst)
+	}
+	l.last = now
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// Wraps an io.Reader, so that reading from it is throttled by the limiter.
+func (l *Limiter) Reader(reader io.Reader) io.ReadCloser {
+	if reader == nil {
+		return nil
+	}
+	rc, ok := reader.(io.ReadCloser)
+	if !ok {
+		rc = ioutil.NopCloser(reader)
+	}
+	return &limitedReader{rc, l}
+}
+
+type limitedReader struct {
+	io.ReadCloser
+	limiter *Limiter
+}
+
+func (lr *limitedReader) Read(p []byte) (n int, err error) {
+	if len(p) > lr.limiter.burst {
+		p = p[:lr.limiter.burst]
+	}
+	n, err = lr.ReadCloser.Read(p)
+	lr.limiter.WaitN(n)
+	return
+}
+
+// Returns an io.Progress which throttles all the transfers it tracks using the limiter.
+// The progress argument may be nil, in which case no progress indication is displayed.
+func WrapProgress(progress ioUtils.Progress, limiter *Limiter) ioUtils.Progress {
+	return &limitedProgress{progress: progress, limiter: limiter}
+}
+
+type limitedProgress struct {
+	progress ioUtils.Progress
+	limiter  *Limiter
+}
+
+func (lp *limitedProgress) New(total int64, prefix, filePath string) (id int) {
+	if lp.progress == nil {
+		return 0
+	}
+	return lp.progress.New(total, prefix, filePath)
+}
+
+func (lp *limitedProgress) NewReplacement(replaceId int, prefix, filePath string) (id int) {
+	if lp.progress == nil {
+		return 0
+	}
+	return lp.progress.NewReplacement(replaceId, prefix, filePath)
+}
+
+func (lp *limitedProgress) ReadWithProgress(id int, reader io.Reader) io.Reader {
+	if lp.progress != nil {
+		reader = lp.progress.ReadWithProgress(id, reader)
+	}
+	return lp.limiter.Reader(reader)
+}
+
+func (lp *limitedProgress) Abort(id int) {
+	if lp.progress != nil {
+		lp.progress.Abort(id)
+	}
+}
+
+func (lp *limitedProgress) Quit() {
+	if lp.progress != nil {
+		lp.progress.Quit()
+	}
+}
+
+// Returns an http.RoundTripper which throttles both the request and the response bodies using the limiter.
+func WrapTransport(transport http.RoundTripper, limiter *Limiter) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &limitedTransport{transport: transport, limiter: limiter}
+}
+
+type limitedTransport struct {
+	transport http.RoundTripper
+	limiter   *Limiter
+}
+
+func (lt *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		// A RoundTripper should not modify the request, therefore a shallow copy is used.
+		limitedReq := new(http.Request)
+		*limitedReq = *req
+		limitedReq.Body = lt.limiter.Reader(req.Body)
+		req = limitedReq
+	}
+	resp, err := lt.transport.RoundTrip(req)
+	if err == nil && resp.Body != nil {
+		resp.Body = lt.limiter.Reader(resp.Body)
+	}
+	return resp, err
+}
+
+// Parses a transfer rate in bytes per second.
+// The rate may be suffixed with K, M or G for kilobytes, megabytes or gigabytes (multiples of 1024).
+func ParseRate(rate string) (int64, error) {
+	value := strings.TrimSpace(rate)
+	multiplier := int64(1)
+	if value != "" {
+		switch strings.ToUpper(value[len(value)-1:]) {
+		case "K":
+			multiplier = 1024
+		case "M":
+			multiplier = 1024 * 1024
+		case "G":
+			multiplier = 1024 * 1024 * 1024
+		}
+		if multiplier > 1 {
+			value = value[:len(value)-1]
+		}
+	}
+	bytesPerSecond, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || bytesPerSecond < 0 {
+		return 0, errorutils.CheckError(errors.New(fmt.Sprintf("Invalid transfer rate '%s'. The rate should be a positive number of bytes per second, optionally followed by K, M or G.", rate)))
+	}
+	return bytesPerSecond * multiplier, nil
+}
+
+// Returns the transfer rate limit in bytes per second, or 0 if no limit is set.
+// The first non empty rate is used. If all are empty, the JFROG_CLI_LIMIT_RATE environment variable is used.
+func GetLimitRate(rates ...string) (int64, error) {
+	for _, rate := range rates {
+		if rate != "" {
+			return ParseRate(rate)
+		}
+	}
+	if rate := os.Getenv(cliutils.LimitRate); rate != "" {
+		return ParseRate(rate)
+	}
+	return 0, nil
+}
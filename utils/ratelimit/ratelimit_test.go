@@ -0,0 +1,84 @@
+package ratelimit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		rate     string
+		expected int64
+		valid    bool
+	}{
+		{"100", 100, true},
+		{"512k", 512 * 1024, true},
+		{"512K", 512 * 1024, true},
+		{"10M", 10 * 1024 * 1024, true},
+		{"1G", 1024 * 1024 * 1024, true},
+		{" 2M ", 2 * 1024 * 1024, true},
+		{"", 0, false},
+		{"M", 0, false},
+		{"10MB", 0, false},
+		{"-1", 0, false},
+		{"fast", 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.rate, func(t *testing.T) {
+			actual, err := ParseRate(test.rate)
+			if test.valid && err != nil {
+				t.Error(err)
+			}
+			if !test.valid && err == nil {
+				t.Errorf("Expected an error for rate '%s'.", test.rate)
+			}
+			if actual != test.expected {
+				t.Errorf("Expected %d, got %d.", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLimiterSharedBetweenReaders(t *testing.T) {
+	const rate = 64 * 1024
+	limiter := NewLimiter(rate)
+	wg := new(sync.WaitGroup)
+	start := time.Now()
+	// The bucket starts full, so 3 readers of one second each should take about 2 seconds.
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reader := limiter.Reader(bytes.NewReader(make([]byte, rate)))
+			if _, err := ioutil.ReadAll(reader); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+	if elapsed < 1900*time.Millisecond {
+		t.Errorf("Expected reading to be throttled to at least 2 seconds, but it took %s.", elapsed)
+	}
+	if elapsed > 4*time.Second {
+		t.Errorf("Expected reading to take about 2 seconds, but it took %s.", elapsed)
+	}
+}
+
+func TestWrapProgressWithoutProgressBar(t *testing.T) {
+	progress := WrapProgress(nil, NewLimiter(1024*1024))
+	id := progress.New(3, "Uploading", "a/b")
+	reader := progress.ReadWithProgress(id, bytes.NewReader([]byte("abc")))
+	content, err := ioutil.ReadAll(reader)
+	if err != nil {
+		t.Error(err)
+	}
+	if string(content) != "abc" {
+		t.Errorf("Expected 'abc', got '%s'.", content)
+	}
+	progress.Abort(id)
+	progress.Quit()
+}